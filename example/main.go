@@ -43,19 +43,19 @@ type container struct {
 }
 
 func (c *container) Store() Store {
-	return c.Get(new(Store)).(Store)
+	return shot.Get[Store](c)
 }
 
 func (c *container) GroupRepository() GroupRepository {
-	return c.Get(new(GroupRepository)).(GroupRepository)
+	return shot.Get[GroupRepository](c)
 }
 
 func (c *container) UserRepository() UserRepository {
-	return c.Get(new(UserRepository)).(UserRepository)
+	return shot.Get[UserRepository](c)
 }
 
 func (c *container) ProjectService() *ProjectService {
-	return c.Get(new(ProjectService)).(*ProjectService)
+	return shot.Get[*ProjectService](c)
 }
 
 func NewProjectService(userRepository UserRepository, groupRepository GroupRepository) *ProjectService {
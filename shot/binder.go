@@ -1,29 +1,81 @@
 package shot
 
-import "sync"
+import (
+	"reflect"
+	"regexp"
+	"runtime"
+	"sync"
+)
 
 type Binder interface {
 	Bind(target interface{}) BindingBuilder
+	Install(modules ...Module)
+	BindScope(scope Scope, impl ScopeImpl)
+	EnableJustInTimeBindings()
+	RequireExplicitBindings()
 	size() int
 	addBinding(binding binding) int
 	setBinding(position int, binding binding)
 	getBinding(position int) binding
 	getBindingAll() []binding
+	getScopeBindings() []scopeBinding
+	getBindingPolicy() bindingPolicy
 }
 
 func newBinder() Binder {
-	return &binder{&sync.Mutex{}, []binding{}}
+	return &binder{
+		mux:       &sync.Mutex{},
+		bindings:  []binding{},
+		installed: make(map[interface{}]bool),
+	}
 }
 
 type binder struct {
-	mux      *sync.Mutex
-	bindings []binding
+	mux       *sync.Mutex
+	bindings  []binding
+	installed map[interface{}]bool
+	modules   []string
+	scopes    []scopeBinding
+	policy    bindingPolicy
 }
 
 func (binder *binder) Bind(target interface{}) BindingBuilder {
 	return newLinkedBindingBuilder(binder, NewKey(target))
 }
 
+func (binder *binder) Install(modules ...Module) {
+	for _, module := range modules {
+		if identity, ok := moduleIdentity(module); ok {
+			if binder.installed[identity] {
+				continue
+			}
+			binder.installed[identity] = true
+		}
+		binder.modules = append(binder.modules, moduleName(module))
+		module.Configure(binder)
+		binder.modules = binder.modules[:len(binder.modules)-1]
+	}
+}
+
+func (binder *binder) BindScope(scope Scope, impl ScopeImpl) {
+	binder.scopes = append(binder.scopes, scopeBinding{scope, impl, binder.currentModule()})
+}
+
+func (binder *binder) EnableJustInTimeBindings() {
+	binder.policy.justInTime = true
+}
+
+func (binder *binder) RequireExplicitBindings() {
+	binder.policy.explicit = true
+}
+
+func (binder *binder) currentModule() string {
+	if len(binder.modules) == 0 {
+		return ""
+	}
+	return binder.modules[len(binder.modules)-1]
+}
+
 func (binder *binder) size() int {
 	return len(binder.bindings)
 }
@@ -31,6 +83,9 @@ func (binder *binder) size() int {
 func (binder *binder) addBinding(binding binding) (size int) {
 	binder.mux.Lock()
 	defer binder.mux.Unlock()
+	if binding.getSource() == "" {
+		binding.base().source = binder.currentModule()
+	}
 	binder.bindings = append(binder.bindings, binding)
 	size = binder.size()
 	return
@@ -47,3 +102,30 @@ func (binder *binder) getBinding(position int) binding {
 func (binder *binder) getBindingAll() []binding {
 	return binder.bindings
 }
+
+func (binder *binder) getScopeBindings() []scopeBinding {
+	return binder.scopes
+}
+
+func (binder *binder) getBindingPolicy() bindingPolicy {
+	return binder.policy
+}
+
+type configureIdentity uintptr
+
+func moduleIdentity(module Module) (interface{}, bool) {
+	switch module := module.(type) {
+	case Configure:
+		pointer := reflect.ValueOf(module).Pointer()
+		return configureIdentity(pointer), !isClosure(runtime.FuncForPC(pointer).Name())
+	case providersModule:
+		return module, reflect.ValueOf(module.providers).Comparable()
+	}
+	return module, reflect.ValueOf(module).Comparable()
+}
+
+var closureName = regexp.MustCompile(`\.func\d+(\.\d+)*$|-fm$`)
+
+func isClosure(funcName string) bool {
+	return closureName.MatchString(funcName)
+}
@@ -7,32 +7,38 @@ import (
 	"sync"
 )
 
+var errorType = reflect.TypeOf((*error)(nil)).Elem()
+
 func newSingletonValue(initialize initialize) *singleton {
-	return &singleton{once: sync.Once{}, initialize: initialize}
+	return &singleton{mux: &sync.Mutex{}, initialize: initialize}
 }
 
-type initialize func() (interface{}, error)
+type initialize func(res *resolution) (interface{}, error)
 
 type singleton struct {
-	once       sync.Once
-	value      interface{}
-	initialize initialize
+	mux         *sync.Mutex
+	initialized bool
+	value       interface{}
+	initialize  initialize
 }
 
-func (s *singleton) get() (interface{}, error) {
-	var err error
-	s.once.Do(func() {
-		s.value, err = s.initialize()
-	})
+func (s *singleton) get(res *resolution) (interface{}, error) {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+	if s.initialized {
+		return s.value, nil
+	}
+	value, err := s.initialize(res)
 	if err != nil {
 		return nil, err
 	}
+	s.value = value
+	s.initialized = true
 	return s.value, nil
 }
 
 type filledBinding interface {
-	ok() error
-	get() interface{}
+	get(res *resolution) (interface{}, error)
 }
 
 func newNoScopeBinding(initialize initialize) filledBinding {
@@ -43,14 +49,8 @@ type noScopeBinding struct {
 	initialize initialize
 }
 
-func (binding *noScopeBinding) ok() error {
-	_, err := binding.initialize()
-	return err
-}
-
-func (binding *noScopeBinding) get() interface{} {
-	value, _ := binding.initialize()
-	return value
+func (binding *noScopeBinding) get(res *resolution) (interface{}, error) {
+	return binding.initialize(res)
 }
 
 func newSingletonBinding(initialize initialize) filledBinding {
@@ -61,14 +61,8 @@ type singletonBinding struct {
 	singleton *singleton
 }
 
-func (binding *singletonBinding) ok() error {
-	_, err := binding.singleton.initialize()
-	return err
-}
-
-func (binding *singletonBinding) get() interface{} {
-	value, _ := binding.singleton.get()
-	return value
+func (binding *singletonBinding) get(res *resolution) (interface{}, error) {
+	return binding.singleton.get(res)
 }
 
 func newEagerSingletonBinding(initialize initialize) filledBinding {
@@ -79,165 +73,269 @@ type eagerSingletonBinding struct {
 	singleton *singleton
 }
 
-func (binding *eagerSingletonBinding) ok() error {
-	_, err := binding.singleton.initialize()
-	return err
+func (binding *eagerSingletonBinding) get(res *resolution) (interface{}, error) {
+	return binding.singleton.get(res)
 }
 
-func (binding *eagerSingletonBinding) get() interface{} {
-	value, _ := binding.singleton.get()
-	return value
+type dependency struct {
+	key       Key
+	valueType reflect.Type
+	requiring reflect.Type
+	field     string
+	index     int
+	optional  bool
 }
 
 type binding interface {
-	fill(injector Injector, tagOnly bool) filledBinding
+	fill(injector Injector, options options) filledBinding
+	base() *bindingBase
 	getScope() Scope
-	withScope(scope Scope) binding
 	getKey() Key
+	getSource() string
+	dependencies(options options) []dependency
+	validate(options options) error
+	providedType() reflect.Type
+}
+
+type bindingBase struct {
+	key     Key
+	scope   Scope
+	source  string
+	onStart []LifecycleHook
+	onStop  []LifecycleHook
+}
+
+func (base *bindingBase) base() *bindingBase {
+	return base
+}
+
+func (base *bindingBase) getScope() Scope {
+	return base.scope
+}
+
+func (base *bindingBase) getKey() Key {
+	return base.key
+}
+
+func (base *bindingBase) getSource() string {
+	return base.source
 }
 
 func newUntargettedBinding(key Key) binding {
 	return &untargettedBinding{
-		key:   key,
-		scope: NoScope,
+		bindingBase{key: key, scope: NoScope},
 	}
 }
 
 type untargettedBinding struct {
-	key   Key
-	scope Scope
+	bindingBase
 }
 
-func (binding *untargettedBinding) fill(injector Injector, tagOnly bool) filledBinding {
-	return resolveBindingScope(binding.scope, func() (interface{}, error) {
-		return buildByStructure(injector, binding.key.Interface(), tagOnly)
+func (binding *untargettedBinding) fill(injector Injector, options options) filledBinding {
+	return resolveBindingScope(injector, binding.base(), func(res *resolution) (interface{}, error) {
+		return buildByStructure(injector, binding.key.Interface(), options, res)
 	})
 }
 
-func (binding *untargettedBinding) getScope() Scope {
-	return binding.scope
+func (binding *untargettedBinding) validate(options options) error {
+	return validateStructure(binding.key.Interface(), options)
 }
 
-func (binding *untargettedBinding) withScope(scope Scope) binding {
-	binding.scope = scope
-	return binding
+func (binding *untargettedBinding) providedType() reflect.Type {
+	return reflect.PtrTo(binding.key.ReflectType())
 }
 
-func (binding *untargettedBinding) getKey() Key {
-	return binding.key
+func (binding *untargettedBinding) dependencies(options options) []dependency {
+	return structureDependencies(binding.key.ReflectType(), options)
 }
 
-func newLinkedBinding(key Key, scope Scope, implementation interface{}) binding {
+func newLinkedBinding(base bindingBase, implementation interface{}) binding {
 	return &linkedBinding{
-		key:            key,
-		scope:          scope,
+		bindingBase:    base,
 		implementation: implementation,
 	}
 }
 
 type linkedBinding struct {
-	key            Key
-	scope          Scope
+	bindingBase
 	implementation interface{}
 }
 
-func (binding *linkedBinding) fill(injector Injector, tagOnly bool) filledBinding {
-	return resolveBindingScope(binding.scope, func() (interface{}, error) {
-		return buildByStructure(injector, binding.implementation, tagOnly)
+func (binding *linkedBinding) fill(injector Injector, options options) filledBinding {
+	return resolveBindingScope(injector, binding.base(), func(res *resolution) (interface{}, error) {
+		return buildByStructure(injector, binding.implementation, options, res)
 	})
 }
 
-func (binding *linkedBinding) getScope() Scope {
-	return binding.scope
+func (binding *linkedBinding) validate(options options) error {
+	return validateStructure(binding.implementation, options)
 }
 
-func (binding *linkedBinding) withScope(scope Scope) binding {
-	binding.scope = scope
-	return binding
+func (binding *linkedBinding) providedType() reflect.Type {
+	structureType, err := structureTypeOf(binding.implementation)
+	if err != nil {
+		return nil
+	}
+	return reflect.PtrTo(structureType)
 }
 
-func (binding *linkedBinding) getKey() Key {
-	return binding.key
+func (binding *linkedBinding) dependencies(options options) []dependency {
+	return structureDependencies(reflect.TypeOf(binding.implementation), options)
 }
 
-func newConstructorBinding(key Key, scope Scope, constructor interface{}) binding {
+func newConstructorBinding(base bindingBase, constructor interface{}) binding {
 	return &constructorBinding{
-		key:         key,
-		scope:       scope,
+		bindingBase: base,
 		constructor: constructor,
 	}
 }
 
 type constructorBinding struct {
-	key         Key
-	scope       Scope
+	bindingBase
 	constructor interface{}
 }
 
-func (binding *constructorBinding) getScope() Scope {
-	return binding.scope
+func (binding *constructorBinding) fill(injector Injector, options options) filledBinding {
+	return resolveBindingScope(injector, binding.base(), func(res *resolution) (interface{}, error) {
+		return buildByConstructor(injector, binding.constructor, res)
+	})
+}
+
+func (binding *constructorBinding) validate(options options) error {
+	_, err := constructorTypeOf(binding.constructor)
+	return err
+}
+
+func (binding *constructorBinding) providedType() reflect.Type {
+	constructorType, err := constructorTypeOf(binding.constructor)
+	if err != nil {
+		return nil
+	}
+	return constructorType.Out(0)
 }
 
-func (binding *constructorBinding) withScope(scope Scope) binding {
-	binding.scope = scope
-	return binding
+func (binding *constructorBinding) dependencies(options options) []dependency {
+	return constructorDependencies(reflect.TypeOf(binding.constructor))
 }
 
-func (binding *constructorBinding) getKey() Key {
-	return binding.key
+func newProviderBinding(base bindingBase, provider interface{}) binding {
+	return &providerBinding{
+		bindingBase: base,
+		provider:    provider,
+	}
 }
 
-func (binding *constructorBinding) fill(injector Injector, tagOnly bool) filledBinding {
-	return resolveBindingScope(binding.scope, func() (interface{}, error) {
-		return buildByConstructor(injector, binding.constructor)
+type providerBinding struct {
+	bindingBase
+	provider interface{}
+}
+
+func (binding *providerBinding) fill(injector Injector, options options) filledBinding {
+	provider := newSingletonValue(func(res *resolution) (interface{}, error) {
+		return injectProvider(injector, binding.provider, options, res)
 	})
+	return resolveBindingScope(injector, binding.base(), func(res *resolution) (interface{}, error) {
+		p, err := provider.get(res)
+		if err != nil {
+			return nil, err
+		}
+		value, err := callConstructor(reflect.ValueOf(p).MethodByName("Get"), nil)
+		if err != nil {
+			return nil, newProvisionError(res.keys(), err)
+		}
+		return value, nil
+	})
+}
+
+func (binding *providerBinding) validate(options options) error {
+	if _, err := providerTypeOf(binding.provider); err != nil {
+		return err
+	}
+	providerType := reflect.TypeOf(binding.provider)
+	if providerType.Kind() != reflect.Ptr && len(structureDependencies(providerType, options)) > 0 {
+		return fmt.Errorf("can't inject fields of a provider not pointer (type %v)", providerType)
+	}
+	return nil
+}
+
+func (binding *providerBinding) providedType() reflect.Type {
+	providerType, err := providerTypeOf(binding.provider)
+	if err != nil {
+		return nil
+	}
+	return providerType.Out(0)
 }
 
-func newInstanceBinding(key Key, scope Scope, instance interface{}) binding {
+func (binding *providerBinding) dependencies(options options) []dependency {
+	return structureDependencies(reflect.TypeOf(binding.provider), options)
+}
+
+func newInstanceBinding(base bindingBase, instance interface{}) binding {
 	return &instanceBinding{
-		key:      key,
-		scope:    scope,
-		instance: instance,
+		bindingBase: base,
+		instance:    instance,
 	}
 }
 
 type instanceBinding struct {
-	key      Key
-	scope    Scope
+	bindingBase
 	instance interface{}
 }
 
-func (binding *instanceBinding) getScope() Scope {
-	return binding.scope
+func (binding *instanceBinding) fill(injector Injector, options options) filledBinding {
+	return resolveBindingScope(injector, binding.base(), func(res *resolution) (interface{}, error) {
+		return binding.instance, nil
+	})
 }
 
-func (binding *instanceBinding) withScope(scope Scope) binding {
-	binding.scope = scope
-	return binding
+func (binding *instanceBinding) validate(options options) error {
+	return nil
 }
 
-func (binding *instanceBinding) getKey() Key {
-	return binding.key
+func (binding *instanceBinding) providedType() reflect.Type {
+	return reflect.TypeOf(binding.instance)
 }
 
-func (binding *instanceBinding) fill(injector Injector, tagOnly bool) filledBinding {
-	return resolveBindingScope(binding.scope, func() (interface{}, error) {
-		return binding.instance, nil
-	})
+func (binding *instanceBinding) dependencies(options options) []dependency {
+	return nil
 }
 
-func resolveBindingScope(scope Scope, initialize initialize) filledBinding {
-	switch scope {
+func resolveBindingScope(injector Injector, base *bindingBase, initialize initialize) filledBinding {
+	key := base.key
+	switch base.scope {
+	case NoScope:
+		return newNoScopeBinding(initialize)
 	case SingletonInstance:
-		return newSingletonBinding(initialize)
+		return newSingletonBinding(injector.getLifecycle().track(base, initialize))
 	case EagerSingleton:
-		return newEagerSingletonBinding(initialize)
+		return newEagerSingletonBinding(injector.getLifecycle().track(base, initialize))
+	case RequestScope:
+		return newRequestScopedBinding(initialize)
 	default:
-		return newNoScopeBinding(initialize)
+		impl, _ := injector.getScopeImpl(base.scope)
+		return newCustomScopedBinding(key, impl, initialize)
 	}
 }
 
-func buildByStructure(injector Injector, structure interface{}, tagOnly bool) (interface{}, error) {
+func buildByStructure(injector Injector, structure interface{}, options options, res *resolution) (interface{}, error) {
+	structureType, err := structureTypeOf(structure)
+	if err != nil {
+		return nil, err
+	}
+
+	structureValue := reflect.Indirect(reflect.New(structureType))
+
+	value, err := fillStructure(injector, structureValue, options, res)
+	if err != nil {
+		return nil, err
+	}
+	if err := postConstruct(value); err != nil {
+		return nil, newProvisionError(res.keys(), err)
+	}
+	return value, nil
+}
+
+func structureTypeOf(structure interface{}) (reflect.Type, error) {
 	structureType := reflect.TypeOf(structure)
 
 	if structureType == nil {
@@ -252,33 +350,106 @@ func buildByStructure(injector Injector, structure interface{}, tagOnly bool) (i
 		return nil, fmt.Errorf("can't reflect a struct not struct (type %v)", structureType)
 	}
 
-	structureValue := reflect.Indirect(reflect.New(structureType))
-
-	return fillStructure(injector, structureValue, tagOnly)
+	return structureType, nil
 }
 
-func fillStructure(injector Injector, structureValue reflect.Value, tagOnly bool) (interface{}, error) {
-	for i := 0; i < structureValue.Type().NumField(); i++ {
-		structField := structureValue.Type().Field(i)
-		if tagOnly {
-			_, ok := structField.Tag.Lookup("inject")
-			if !ok {
-				continue
-			}
+func validateStructure(structure interface{}, options options) error {
+	structureType, err := structureTypeOf(structure)
+	if err != nil {
+		return err
+	}
+	for _, structField := range injectableFields(structureType, options) {
+		if structField.PkgPath != "" {
+			return errors.New("can't set a private field of struct")
 		}
-		structValueField := structureValue.Field(i)
+	}
+	return nil
+}
+
+func fillStructure(injector Injector, structureValue reflect.Value, options options, res *resolution) (interface{}, error) {
+	for _, structField := range injectableFields(structureValue.Type(), options) {
+		structValueField := structureValue.FieldByIndex(structField.Index)
 		if !structValueField.CanSet() {
 			return nil, errors.New("can't set a private field of struct")
 		}
-		value := reflect.ValueOf(injector.GetByKey(NewKeyByType(structField.Type)))
+		key := fieldKey(structField)
+		if parseInjectTag(structField.Tag.Get(injectTagName)).optional && !canResolve(injector, key) {
+			continue
+		}
+		value, err := resolveDependency(injector, key, structField.Type, res)
+		if err != nil {
+			return nil, err
+		}
 		structValueField.Set(value)
 	}
 	return structureValue.Addr().Interface(), nil
 }
 
-func buildByConstructor(injector Injector, constructorFunc interface{}) (interface{}, error) {
+func injectableFields(structureType reflect.Type, options options) []reflect.StructField {
+	var fields []reflect.StructField
+	for i := 0; i < structureType.NumField(); i++ {
+		structField := structureType.Field(i)
+		if _, ok := structField.Tag.Lookup(injectTagName); options.tagOnly && !ok {
+			continue
+		}
+		fields = append(fields, structField)
+	}
+	return fields
+}
+
+func fieldKey(structField reflect.StructField) Key {
+	tag := parseInjectTag(structField.Tag.Get(injectTagName))
+	return NewNamedKeyByType(structField.Type, tag.name)
+}
+
+func structureDependencies(structureType reflect.Type, options options) []dependency {
+	if structureType == nil {
+		return nil
+	}
+	if structureType.Kind() == reflect.Ptr {
+		structureType = structureType.Elem()
+	}
+	if structureType.Kind() != reflect.Struct {
+		return nil
+	}
+	var dependencies []dependency
+	for _, structField := range injectableFields(structureType, options) {
+		key, optional := dependencyKey(fieldKey(structField))
+		dependencies = append(dependencies, dependency{
+			key:       key,
+			valueType: dependencyType(structField.Type),
+			requiring: structureType,
+			field:     structField.Name,
+			index:     -1,
+			optional:  optional || parseInjectTag(structField.Tag.Get(injectTagName)).optional,
+		})
+	}
+	return dependencies
+}
 
-	constructor := reflect.ValueOf(constructorFunc)
+func buildByConstructor(injector Injector, constructorFunc interface{}, res *resolution) (interface{}, error) {
+
+	constructorType, err := constructorTypeOf(constructorFunc)
+	if err != nil {
+		return nil, err
+	}
+
+	constructorArgs, err := buildArgs(injector, constructorType, res)
+	if err != nil {
+		return nil, err
+	}
+
+	value, err := callConstructor(reflect.ValueOf(constructorFunc), constructorArgs)
+	if err == nil {
+		err = postConstruct(value)
+	}
+	if err != nil {
+		return nil, newProvisionError(res.keys(), err)
+	}
+	return value, nil
+}
+
+func constructorTypeOf(constructorFunc interface{}) (reflect.Type, error) {
 	constructorType := reflect.TypeOf(constructorFunc)
 
 	if constructorType == nil {
@@ -289,27 +460,130 @@ func buildByConstructor(injector Injector, constructorFunc interface{}) (interfa
 		return nil, fmt.Errorf("can't reflect a constructorFunc not function (type %v)", constructorType)
 	}
 
-	constructorArgs := buildArgs(injector, constructorType)
+	switch {
+	case constructorType.NumOut() == 1:
+	case constructorType.NumOut() == 2 && constructorType.Out(1) == errorType:
+	default:
+		return nil, errors.New("a constructor should return only one result or a result and an error")
+	}
+
+	return constructorType, nil
+}
 
-	return callConstructor(constructor, constructorArgs)
+func providerTypeOf(provider interface{}) (reflect.Type, error) {
+	if provider == nil {
+		return nil, errors.New("can't reflect a provider nil")
+	}
+	method := reflect.ValueOf(provider).MethodByName("Get")
+	if !method.IsValid() {
+		return nil, fmt.Errorf("can't reflect a provider without Get method (type %T)", provider)
+	}
+	methodType := method.Type()
+	if methodType.NumIn() != 0 {
+		return nil, fmt.Errorf("can't reflect a provider whose Get method has parameters (type %T)", provider)
+	}
+	switch {
+	case methodType.NumOut() == 1:
+	case methodType.NumOut() == 2 && methodType.Out(1) == errorType:
+	default:
+		return nil, fmt.Errorf("can't reflect a provider whose Get method does not return (T) or (T, error) (type %T)", provider)
+	}
+	return methodType, nil
+}
+
+func injectProvider(injector Injector, provider interface{}, options options, res *resolution) (interface{}, error) {
+	providerValue := reflect.ValueOf(provider)
+	if providerValue.Kind() != reflect.Ptr || providerValue.Elem().Kind() != reflect.Struct {
+		return provider, nil
+	}
+	copied := reflect.New(providerValue.Elem().Type())
+	copied.Elem().Set(providerValue.Elem())
+	if _, err := fillStructure(injector, copied.Elem(), options, res); err != nil {
+		return nil, err
+	}
+	if err := postConstruct(copied.Interface()); err != nil {
+		return nil, newProvisionError(res.keys(), err)
+	}
+	return copied.Interface(), nil
 }
 
 func callConstructor(constructor reflect.Value, constructorArgs []reflect.Value) (interface{}, error) {
 	values := constructor.Call(constructorArgs)
 
-	if len(values) != 1 {
-		return nil, errors.New("a constructor should return only one result")
+	if len(values) == 2 && !values[1].IsNil() {
+		return nil, values[1].Interface().(error)
 	}
 
 	return values[0].Interface(), nil
 }
 
-func buildArgs(injector Injector, constructorType reflect.Type) []reflect.Value {
+func buildArgs(injector Injector, constructorType reflect.Type, res *resolution) ([]reflect.Value, error) {
 	var args []reflect.Value
 	for i := 0; i < constructorType.NumIn(); i++ {
 		argType := constructorType.In(i)
-		value := reflect.ValueOf(injector.GetByKey(NewKeyByType(argType)))
+		value, err := resolveDependency(injector, NewKeyByType(argType), argType, res)
+		if err != nil {
+			return nil, err
+		}
 		args = append(args, value)
 	}
-	return args
+	return args, nil
+}
+
+func resolveDependency(injector Injector, key Key, valueType reflect.Type, res *resolution) (reflect.Value, error) {
+	if elementKey, ok := optionalKey(key); ok {
+		return resolveOptionalValue(injector, elementKey, valueType, res)
+	}
+	return resolveValue(injector, key, valueType, res)
+}
+
+func resolveValue(injector Injector, key Key, valueType reflect.Type, res *resolution) (reflect.Value, error) {
+	if !injector.hasBinding(key) {
+		if providedKey, ok := providerKey(key); ok {
+			return newProviderValue(injector, valueType, providedKey, res), nil
+		}
+	}
+	value, err := injector.getByKey(key, res)
+	if err != nil {
+		return reflect.Value{}, err
+	}
+	if value == nil {
+		return reflect.Zero(valueType), nil
+	}
+	if !reflect.TypeOf(value).AssignableTo(valueType) {
+		return reflect.Value{}, newProvisionError(append(res.keys(), key), fmt.Errorf("can't inject %T into %v", value, valueType))
+	}
+	return reflect.ValueOf(value), nil
+}
+
+func constructorDependencies(constructorType reflect.Type) []dependency {
+	if constructorType == nil || constructorType.Kind() != reflect.Func {
+		return nil
+	}
+	var dependencies []dependency
+	for i := 0; i < constructorType.NumIn(); i++ {
+		key, optional := dependencyKey(NewKeyByType(constructorType.In(i)))
+		dependencies = append(dependencies, dependency{
+			key:       key,
+			valueType: dependencyType(constructorType.In(i)),
+			requiring: constructorType,
+			index:     i,
+			optional:  optional,
+		})
+	}
+	return dependencies
+}
+
+func dependencyKey(key Key) (Key, bool) {
+	if elementKey, ok := optionalKey(key); ok {
+		return elementKey, true
+	}
+	return key, false
+}
+
+func dependencyType(valueType reflect.Type) reflect.Type {
+	if isOptional(valueType) {
+		return valueType.Field(0).Type
+	}
+	return valueType
 }
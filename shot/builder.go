@@ -4,6 +4,10 @@ type BindingBuilder interface {
 	To(implementation interface{}) BindingBuilder
 	ToConstructor(constructor interface{}) BindingBuilder
 	ToInstance(instance interface{}) BindingBuilder
+	ToProvider(provider interface{}) BindingBuilder
+	Named(name string) BindingBuilder
+	OnStart(hook LifecycleHook) BindingBuilder
+	OnStop(hook LifecycleHook) BindingBuilder
 	In(scope Scope)
 	AsEagerSingleton()
 }
@@ -24,30 +28,52 @@ type linkedBindingBuilder struct {
 
 func (builder *linkedBindingBuilder) To(implementation interface{}) BindingBuilder {
 	base := builder.getBinding()
-	builder.setBinding(newLinkedBinding(base.getKey(), base.getScope(), implementation))
+	builder.setBinding(newLinkedBinding(*base.base(), implementation))
 	return builder
 }
 
 func (builder *linkedBindingBuilder) ToConstructor(constructor interface{}) BindingBuilder {
 	base := builder.getBinding()
-	builder.setBinding(newConstructorBinding(base.getKey(), base.getScope(), constructor))
+	builder.setBinding(newConstructorBinding(*base.base(), constructor))
 	return builder
 }
 
 func (builder *linkedBindingBuilder) ToInstance(instance interface{}) BindingBuilder {
 	base := builder.getBinding()
-	builder.setBinding(newInstanceBinding(base.getKey(), base.getScope(), instance))
+	builder.setBinding(newInstanceBinding(*base.base(), instance))
+	return builder
+}
+
+func (builder *linkedBindingBuilder) ToProvider(provider interface{}) BindingBuilder {
+	base := builder.getBinding()
+	builder.setBinding(newProviderBinding(*base.base(), provider))
+	return builder
+}
+
+func (builder *linkedBindingBuilder) Named(name string) BindingBuilder {
+	base := builder.getBinding().base()
+	base.key = withName(base.key, name)
+	return builder
+}
+
+func (builder *linkedBindingBuilder) OnStart(hook LifecycleHook) BindingBuilder {
+	base := builder.getBinding().base()
+	base.onStart = append(base.onStart, hook)
+	return builder
+}
+
+func (builder *linkedBindingBuilder) OnStop(hook LifecycleHook) BindingBuilder {
+	base := builder.getBinding().base()
+	base.onStop = append(base.onStop, hook)
 	return builder
 }
 
 func (builder *linkedBindingBuilder) In(scope Scope) {
-	binding := builder.getBinding().withScope(scope)
-	builder.binder.setBinding(builder.position, binding)
+	builder.getBinding().base().scope = scope
 }
 
 func (builder *linkedBindingBuilder) AsEagerSingleton() {
-	binding := builder.getBinding().withScope(EagerSingleton)
-	builder.binder.setBinding(builder.position, binding)
+	builder.getBinding().base().scope = EagerSingleton
 }
 
 func (builder *linkedBindingBuilder) getBinding() binding {
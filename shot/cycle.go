@@ -0,0 +1,57 @@
+package shot
+
+import "fmt"
+
+func newCycleError(keys []Key) error {
+	return fmt.Errorf("cycle: %s", joinKeys(keys))
+}
+
+func detectCycle(bindings []binding, options options) error {
+	dependencies := make(map[Key][]dependency)
+	for _, binding := range bindings {
+		dependencies[binding.getKey()] = binding.dependencies(options)
+	}
+
+	const (
+		unvisited = iota
+		visiting
+		visited
+	)
+	states := make(map[Key]int)
+	var path []Key
+
+	var visit func(key Key) error
+	visit = func(key Key) error {
+		switch states[key] {
+		case visiting:
+			for i, k := range path {
+				if k == key {
+					return newCycleError(append(path[i:len(path):len(path)], key))
+				}
+			}
+		case visited:
+			return nil
+		}
+		requirements, ok := dependencies[key]
+		if !ok {
+			return nil
+		}
+		states[key] = visiting
+		path = append(path, key)
+		for _, requirement := range requirements {
+			if err := visit(requirement.key); err != nil {
+				return err
+			}
+		}
+		path = path[:len(path)-1]
+		states[key] = visited
+		return nil
+	}
+
+	for _, binding := range bindings {
+		if err := visit(binding.getKey()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,67 @@
+package shot
+
+import (
+	"strings"
+	"testing"
+)
+
+type CyclicProjectService struct {
+	UserRepository CyclicUserRepository `inject:""`
+}
+
+type CyclicUserRepository interface {
+	FindAll() []string
+}
+
+type CyclicUserRepositoryOnMemory struct {
+	ProjectService *CyclicProjectService `inject:""`
+}
+
+func (repository *CyclicUserRepositoryOnMemory) FindAll() []string {
+	return []string{}
+}
+
+func NewCyclicUserRepositoryOnMemory(projectService *CyclicProjectService) *CyclicUserRepositoryOnMemory {
+	return &CyclicUserRepositoryOnMemory{projectService}
+}
+
+func Test_it_should_be_detect_a_cycle_via_struct(t *testing.T) {
+	_, err := CreateInjector(func(binder Binder) {
+		binder.Bind(new(CyclicProjectService))
+		binder.Bind(new(CyclicUserRepository)).To(new(CyclicUserRepositoryOnMemory))
+	})
+	if err == nil {
+		t.Fatal("a cycle should be detected")
+	}
+	expected := "cycle: shot.CyclicProjectService -> shot.CyclicUserRepository -> shot.CyclicProjectService"
+	if err.Error() != expected {
+		t.Fatalf("Does not match. result: %v", err)
+	}
+}
+
+func Test_it_should_be_detect_a_cycle_via_constructor(t *testing.T) {
+	_, err := CreateInjector(func(binder Binder) {
+		binder.Bind(new(CyclicUserRepository)).ToConstructor(NewCyclicUserRepositoryOnMemory).AsEagerSingleton()
+		binder.Bind(new(CyclicProjectService)).AsEagerSingleton()
+	})
+	if err == nil {
+		t.Fatal("a cycle should be detected")
+	}
+	expected := "cycle: shot.CyclicUserRepository -> shot.CyclicProjectService -> shot.CyclicUserRepository"
+	if err.Error() != expected {
+		t.Fatalf("Does not match. result: %v", err)
+	}
+}
+
+func Test_it_should_be_detect_a_cycle_while_resolving(t *testing.T) {
+	injector := newInjector(nil, newOptions(), false)
+	projectService := newUntargettedBinding(NewKey(new(CyclicProjectService)))
+	userRepository := newLinkedBinding(bindingBase{key: NewKey(new(CyclicUserRepository)), scope: SingletonInstance}, new(CyclicUserRepositoryOnMemory))
+	injector.set(projectService.getKey(), projectService.fill(injector, newOptions()))
+	injector.set(userRepository.getKey(), userRepository.fill(injector, newOptions()))
+
+	_, err := injector.SafeGet(new(CyclicProjectService))
+	if err == nil || !strings.HasPrefix(err.Error(), "cycle: ") {
+		t.Fatalf("a cycle should be detected. result: %v", err)
+	}
+}
@@ -0,0 +1,93 @@
+package shot
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+type provisionError struct {
+	keys []Key
+	err  error
+}
+
+func newProvisionError(keys []Key, err error) error {
+	if _, ok := err.(*provisionError); ok {
+		return err
+	}
+	return &provisionError{keys: keys, err: err}
+}
+
+func (e *provisionError) Error() string {
+	if len(e.keys) == 0 {
+		return e.err.Error()
+	}
+	return fmt.Sprintf("could not provide %s: %v", joinKeys(e.keys), e.err)
+}
+
+func (e *provisionError) Unwrap() error {
+	return e.err
+}
+
+func joinKeys(keys []Key) string {
+	names := make([]string, len(keys))
+	for i, key := range keys {
+		names[i] = fmt.Sprint(key)
+	}
+	return strings.Join(names, " -> ")
+}
+
+type MissingDependencyError struct {
+	Key       Key
+	Binding   Key
+	Module    string
+	Requiring reflect.Type
+	Field     string
+	Index     int
+}
+
+func (e *MissingDependencyError) Error() string {
+	binding := fmt.Sprint(e.Binding)
+	if e.Module != "" {
+		binding = fmt.Sprintf("%s in module %s", binding, e.Module)
+	}
+	if e.Field != "" {
+		return fmt.Sprintf("could not find a binding for %v required by field %s of %v (binding %s)", e.Key, e.Field, e.Requiring, binding)
+	}
+	return fmt.Sprintf("could not find a binding for %v required by parameter %d of %v (binding %s)", e.Key, e.Index, e.Requiring, binding)
+}
+
+type CreationError struct {
+	Errors []error
+}
+
+func (e *CreationError) Error() string {
+	return formatErrors("creating injector", e.Errors)
+}
+
+func (e *CreationError) Unwrap() []error {
+	return e.Errors
+}
+
+type LifecycleError struct {
+	Errors []error
+}
+
+func (e *LifecycleError) Error() string {
+	return formatErrors("running lifecycle hooks", e.Errors)
+}
+
+func (e *LifecycleError) Unwrap() []error {
+	return e.Errors
+}
+
+func formatErrors(doing string, errs []error) string {
+	if len(errs) == 1 {
+		return errs[0].Error()
+	}
+	messages := make([]string, len(errs))
+	for i, err := range errs {
+		messages[i] = fmt.Sprintf("%d) %v", i+1, err)
+	}
+	return fmt.Sprintf("%d errors %s:\n%s", len(errs), doing, strings.Join(messages, "\n"))
+}
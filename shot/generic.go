@@ -0,0 +1,95 @@
+package shot
+
+import (
+	"fmt"
+	"reflect"
+)
+
+func KeyOf[T any]() Key {
+	return NewKeyByType(reflect.TypeOf((*T)(nil)).Elem())
+}
+
+func NamedKeyOf[T any](name string) Key {
+	return NewNamedKeyByType(reflect.TypeOf((*T)(nil)).Elem(), name)
+}
+
+type TypedBindingBuilder[T any] interface {
+	To(implementation T) TypedBindingBuilder[T]
+	ToConstructor(constructor interface{}) TypedBindingBuilder[T]
+	ToInstance(instance T) TypedBindingBuilder[T]
+	ToProvider(provider interface{}) TypedBindingBuilder[T]
+	Named(name string) TypedBindingBuilder[T]
+	OnStart(hook LifecycleHook) TypedBindingBuilder[T]
+	OnStop(hook LifecycleHook) TypedBindingBuilder[T]
+	In(scope Scope)
+	AsEagerSingleton()
+}
+
+func Bind[T any](binder Binder) TypedBindingBuilder[T] {
+	return &typedBindingBuilder[T]{newLinkedBindingBuilder(binder, KeyOf[T]())}
+}
+
+type typedBindingBuilder[T any] struct {
+	builder BindingBuilder
+}
+
+func (builder *typedBindingBuilder[T]) To(implementation T) TypedBindingBuilder[T] {
+	builder.builder.To(implementation)
+	return builder
+}
+
+func (builder *typedBindingBuilder[T]) ToConstructor(constructor interface{}) TypedBindingBuilder[T] {
+	builder.builder.ToConstructor(constructor)
+	return builder
+}
+
+func (builder *typedBindingBuilder[T]) ToInstance(instance T) TypedBindingBuilder[T] {
+	builder.builder.ToInstance(instance)
+	return builder
+}
+
+func (builder *typedBindingBuilder[T]) ToProvider(provider interface{}) TypedBindingBuilder[T] {
+	builder.builder.ToProvider(provider)
+	return builder
+}
+
+func (builder *typedBindingBuilder[T]) Named(name string) TypedBindingBuilder[T] {
+	builder.builder.Named(name)
+	return builder
+}
+
+func (builder *typedBindingBuilder[T]) OnStart(hook LifecycleHook) TypedBindingBuilder[T] {
+	builder.builder.OnStart(hook)
+	return builder
+}
+
+func (builder *typedBindingBuilder[T]) OnStop(hook LifecycleHook) TypedBindingBuilder[T] {
+	builder.builder.OnStop(hook)
+	return builder
+}
+
+func (builder *typedBindingBuilder[T]) In(scope Scope) {
+	builder.builder.In(scope)
+}
+
+func (builder *typedBindingBuilder[T]) AsEagerSingleton() {
+	builder.builder.AsEagerSingleton()
+}
+
+func Get[T any](injector Injector) T {
+	value, _ := injector.GetByKey(KeyOf[T]()).(T)
+	return value
+}
+
+func SafeGet[T any](injector Injector) (T, error) {
+	var zero T
+	value, err := injector.SafeGetByKey(KeyOf[T]())
+	if err != nil {
+		return zero, err
+	}
+	typed, ok := value.(T)
+	if !ok {
+		return zero, fmt.Errorf("a binding for %v provides %T, not %v", KeyOf[T](), value, reflect.TypeOf((*T)(nil)).Elem())
+	}
+	return typed, nil
+}
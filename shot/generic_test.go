@@ -0,0 +1,42 @@
+package shot
+
+import "testing"
+
+func Test_it_should_be_inject_via_generic_api(t *testing.T) {
+	injector, err := CreateInjector(func(binder Binder) {
+		Bind[Store](binder).ToConstructor(NewStoreOnMemory).In(SingletonInstance)
+		Bind[UserRepository](binder).To(new(UserRepositoryOnMemory))
+		Bind[GroupRepository](binder).ToConstructor(NewGroupRepositoryOnMemory)
+		Bind[*ProjectService](binder)
+		Bind[string](binder).Named("dsn").ToInstance("primary-dsn")
+	})
+	if err != nil {
+		t.Fatalf("fatal: %v", err)
+	}
+	if KeyOf[*ProjectService]() != NewKey(new(ProjectService)) {
+		t.Fatalf("Wrongs %v, %v", KeyOf[*ProjectService](), NewKey(new(ProjectService)))
+	}
+	userRepository := Get[UserRepository](injector)
+	if userRepository == nil {
+		t.Fatal("not found a UserRepository")
+	}
+	if userRepository.FindAll() == nil {
+		t.Fatal("could not inject field of UserRepository")
+	}
+	projectService, err := SafeGet[*ProjectService](injector)
+	if err != nil {
+		t.Fatalf("fatal: %v", err)
+	}
+	if projectService.FindGroup() == nil {
+		t.Fatal("could not inject field of ProjectService")
+	}
+	if _, err := SafeGet[ProjectService](injector); err == nil {
+		t.Fatal("a mismatched type should be reported")
+	}
+	if dsn := injector.GetNamed(new(string), "dsn"); dsn != "primary-dsn" {
+		t.Fatalf("Does not match. result: %v", dsn)
+	}
+	if _, err := SafeGet[*StoreOnMemory](injector); err == nil {
+		t.Fatal("an unbound type should be reported")
+	}
+}
@@ -1,22 +1,55 @@
 package shot
 
-import "fmt"
+import (
+	"context"
+	"fmt"
+	"sync"
+)
 
 type Injector interface {
 	Get(from interface{}) interface{}
 	GetByKey(key Key) interface{}
+	GetNamed(from interface{}, name string) interface{}
 	SafeGet(from interface{}) (interface{}, error)
+	SafeGetNamed(from interface{}, name string) (interface{}, error)
 	SafeGetByKey(key Key) (interface{}, error)
+	GetCtx(ctx context.Context, key Key) interface{}
+	SafeGetCtx(ctx context.Context, key Key) (interface{}, error)
+	CreateChildInjector(configures ...Configure) (Injector, error)
+	Start(ctx context.Context) error
+	Close(ctx context.Context) error
+	getByKey(key Key, res *resolution) (interface{}, error)
+	hasBinding(key Key) bool
+	justInTime() bool
+	getScopeImpl(scope Scope) (ScopeImpl, bool)
+	setScopeImpl(scope Scope, impl ScopeImpl)
+	getLifecycle() *lifecycle
 	set(key Key, binding filledBinding)
 	getBindings() map[Key]filledBinding
 }
 
-func newInjector() Injector {
-	return &injector{make(map[Key]filledBinding)}
+func newInjector(parent Injector, options options, justInTime bool) Injector {
+	return &injector{
+		mux:       &sync.RWMutex{},
+		jitMux:    &sync.Mutex{},
+		bindings:  make(map[Key]filledBinding),
+		scopes:    make(map[Scope]ScopeImpl),
+		lifecycle: newLifecycle(),
+		parent:    parent,
+		options:   options,
+		jit:       justInTime,
+	}
 }
 
 type injector struct {
-	bindings map[Key]filledBinding
+	mux       *sync.RWMutex
+	jitMux    *sync.Mutex
+	bindings  map[Key]filledBinding
+	scopes    map[Scope]ScopeImpl
+	lifecycle *lifecycle
+	parent    Injector
+	options   options
+	jit       bool
 }
 
 func (i *injector) Get(from interface{}) interface{} {
@@ -24,26 +57,135 @@ func (i *injector) Get(from interface{}) interface{} {
 }
 
 func (i *injector) GetByKey(key Key) interface{} {
-	binding, ok := i.bindings[key]
-	if !ok {
-		return nil
-	}
-	return binding.get()
+	value, _ := i.getByKey(key, nil)
+	return value
+}
+
+func (i *injector) GetNamed(from interface{}, name string) interface{} {
+	return i.GetByKey(NewNamedKey(from, name))
 }
 
 func (i *injector) SafeGet(from interface{}) (interface{}, error) {
 	return i.SafeGetByKey(NewKey(from))
 }
 
+func (i *injector) SafeGetNamed(from interface{}, name string) (interface{}, error) {
+	return i.SafeGetByKey(NewNamedKey(from, name))
+}
+
 func (i *injector) SafeGetByKey(key Key) (interface{}, error) {
+	return i.getByKey(key, nil)
+}
+
+func (i *injector) GetCtx(ctx context.Context, key Key) interface{} {
+	value, _ := i.SafeGetCtx(ctx, key)
+	return value
+}
+
+func (i *injector) SafeGetCtx(ctx context.Context, key Key) (interface{}, error) {
+	res := newResolution(ctx)
+	defer res.finish()
+	return i.getByKey(key, res)
+}
+
+func (i *injector) CreateChildInjector(configures ...Configure) (Injector, error) {
+	return newInternalInjectorCreator(i.options.inherit()).
+		withParent(i).
+		addConfigures(configures...).
+		build()
+}
+
+func (i *injector) Start(ctx context.Context) error {
+	return i.lifecycle.start(ctx)
+}
+
+func (i *injector) Close(ctx context.Context) error {
+	return i.lifecycle.stop(ctx)
+}
+
+func (i *injector) getByKey(key Key, res *resolution) (interface{}, error) {
+	binding, ok := i.getBinding(key)
+	if !ok && i.parent != nil && (!i.jit || i.parent.hasBinding(key)) {
+		return i.parent.getByKey(key, res)
+	}
+	if !ok && i.jit {
+		var err error
+		if binding, ok, err = i.bindJustInTime(key); err != nil {
+			return nil, newProvisionError(append(res.keys(), key), err)
+		}
+	}
+	if !ok {
+		return nil, fmt.Errorf("could not find a binding for %v", key)
+	}
+	if res.contains(key) {
+		return nil, newCycleError(res.cycle(key))
+	}
+	res = res.push(key)
+	defer res.finish()
+	return binding.get(res)
+}
+
+func (i *injector) getBinding(key Key) (filledBinding, bool) {
+	i.mux.RLock()
+	defer i.mux.RUnlock()
 	binding, ok := i.bindings[key]
+	return binding, ok
+}
+
+func (i *injector) hasBinding(key Key) bool {
+	if _, ok := i.getBinding(key); ok {
+		return true
+	}
+	return i.parent != nil && i.parent.hasBinding(key)
+}
+
+func (i *injector) justInTime() bool {
+	return i.jit
+}
+
+func (i *injector) bindJustInTime(key Key) (filledBinding, bool, error) {
+	i.jitMux.Lock()
+	defer i.jitMux.Unlock()
+	if binding, ok := i.getBinding(key); ok {
+		return binding, true, nil
+	}
+	created, ok := justInTimeBinding(key)
 	if !ok {
-		return nil, fmt.Errorf("could not find a binding for %s", key.ReflectType().String())
+		return nil, false, nil
+	}
+	bindings := append([]binding{created}, justInTimeBindings([]binding{created}, i.options, i)...)
+	if err := validateBindings(bindings, nil, i.options, i); err != nil {
+		return nil, false, err
+	}
+	for _, binding := range bindings {
+		i.set(binding.getKey(), binding.fill(i, i.options))
+		i.options.logf("shot: created just-in-time binding for %v", binding.getKey())
+	}
+	filled, _ := i.getBinding(key)
+	return filled, true, nil
+}
+
+func (i *injector) getScopeImpl(scope Scope) (ScopeImpl, bool) {
+	if impl, ok := i.scopes[scope]; ok {
+		return impl, true
 	}
-	return binding.get(), nil
+	if i.parent != nil {
+		return i.parent.getScopeImpl(scope)
+	}
+	return nil, false
+}
+
+func (i *injector) setScopeImpl(scope Scope, impl ScopeImpl) {
+	i.scopes[scope] = impl
+}
+
+func (i *injector) getLifecycle() *lifecycle {
+	return i.lifecycle
 }
 
 func (i *injector) set(key Key, binding filledBinding) {
+	i.mux.Lock()
+	defer i.mux.Unlock()
 	i.bindings[key] = binding
 }
 
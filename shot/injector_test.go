@@ -0,0 +1,46 @@
+package shot
+
+import (
+	"strings"
+	"testing"
+)
+
+func Test_it_should_be_resolve_bindings_via_child_injector(t *testing.T) {
+	parent, err := CreateInjector(func(binder Binder) {
+		binder.Bind(new(Store)).ToConstructor(NewStoreOnMemory).In(SingletonInstance)
+	})
+	if err != nil {
+		t.Fatalf("fatal: %v", err)
+	}
+	child, err := parent.CreateChildInjector(func(binder Binder) {
+		binder.Bind(new(UserRepository)).ToConstructor(NewUserRepositoryOnMemory)
+	})
+	if err != nil {
+		t.Fatalf("fatal: %v", err)
+	}
+	userRepository := child.Get(new(UserRepository)).(*UserRepositoryOnMemory)
+	if userRepository.FindAll() == nil {
+		t.Fatal("could not inject field of UserRepository")
+	}
+	if userRepository.Store != parent.Get(new(Store)) {
+		t.Fatal("a child injector should share singletons of the parent")
+	}
+	if _, err := parent.SafeGet(new(UserRepository)); err == nil {
+		t.Fatal("a binding of the child injector should not be visible to the parent")
+	}
+}
+
+func Test_it_should_be_report_bindings_of_child_injector_rebinding_the_parent(t *testing.T) {
+	parent, err := CreateInjector(func(binder Binder) {
+		binder.Bind(new(Store)).ToConstructor(NewStoreOnMemory)
+	})
+	if err != nil {
+		t.Fatalf("fatal: %v", err)
+	}
+	_, err = parent.CreateChildInjector(func(binder Binder) {
+		binder.Bind(new(Store)).To(new(StoreOnMemory))
+	})
+	if err == nil || !strings.Contains(err.Error(), "was already configured in the parent injector") {
+		t.Fatalf("Does not match. result: %v", err)
+	}
+}
@@ -0,0 +1,99 @@
+package shot
+
+import (
+	"reflect"
+	"sync"
+)
+
+const justInTimeSource = "just-in-time"
+
+var defaultBindings = struct {
+	mux      *sync.RWMutex
+	bindings map[Key]func(base bindingBase) binding
+}{
+	mux:      &sync.RWMutex{},
+	bindings: make(map[Key]func(base bindingBase) binding),
+}
+
+func ImplementedBy(target interface{}, implementation interface{}) {
+	registerDefaultBinding(NewKey(target), func(base bindingBase) binding {
+		return newLinkedBinding(base, implementation)
+	})
+}
+
+func ProvidedBy(target interface{}, constructor interface{}) {
+	registerDefaultBinding(NewKey(target), func(base bindingBase) binding {
+		return newConstructorBinding(base, constructor)
+	})
+}
+
+func registerDefaultBinding(key Key, newBinding func(base bindingBase) binding) {
+	defaultBindings.mux.Lock()
+	defer defaultBindings.mux.Unlock()
+	defaultBindings.bindings[key] = newBinding
+}
+
+func defaultBinding(key Key) (func(base bindingBase) binding, bool) {
+	defaultBindings.mux.RLock()
+	defer defaultBindings.mux.RUnlock()
+	newBinding, ok := defaultBindings.bindings[key]
+	return newBinding, ok
+}
+
+type bindingPolicy struct {
+	justInTime bool
+	explicit   bool
+}
+
+func (policy bindingPolicy) allowJustInTime(parent Injector) bool {
+	if policy.explicit {
+		return false
+	}
+	return policy.justInTime || (parent != nil && parent.justInTime())
+}
+
+func justInTimeBinding(k Key) (binding, bool) {
+	if k.Name() != "" || elementOf(k) != nil {
+		return nil, false
+	}
+	if newBinding, ok := defaultBinding(k); ok {
+		return newBinding(bindingBase{key: k, scope: NoScope, source: justInTimeSource}), true
+	}
+	if k.ReflectType().Kind() != reflect.Struct {
+		return nil, false
+	}
+	binding := newUntargettedBinding(k)
+	binding.base().source = justInTimeSource
+	return binding, true
+}
+
+func justInTimeBindings(bindings []binding, options options, parent Injector) []binding {
+	bound := make(map[Key]bool)
+	for _, binding := range bindings {
+		bound[binding.getKey()] = true
+	}
+	var created []binding
+	pending := bindings
+	for len(pending) > 0 {
+		binding := pending[0]
+		pending = pending[1:]
+		for _, dependency := range binding.dependencies(options) {
+			key, valueType := dependency.key, dependency.valueType
+			if providedKey, ok := providerKey(key); ok && !bound[key] {
+				key, valueType = providedKey, valueType.Out(0)
+			}
+			if dependency.optional || bound[key] || (parent != nil && parent.hasBinding(key)) {
+				continue
+			}
+			if valueType.Kind() == reflect.Struct {
+				continue
+			}
+			if binding, ok := justInTimeBinding(key); ok {
+				bound[key] = true
+				created = append(created, binding)
+				pending = append(pending, binding)
+			}
+		}
+	}
+	return created
+}
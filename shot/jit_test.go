@@ -0,0 +1,189 @@
+package shot
+
+import (
+	"strings"
+	"testing"
+)
+
+type DashboardService struct {
+	ProjectService *ProjectService `inject:""`
+}
+
+func Test_it_should_be_create_just_in_time_bindings_for_concrete_structs(t *testing.T) {
+	injector, err := CreateInjector(func(binder Binder) {
+		binder.EnableJustInTimeBindings()
+		binder.Bind(new(Store)).ToConstructor(NewStoreOnMemory)
+		binder.Bind(new(UserRepository)).To(new(UserRepositoryOnMemory))
+		binder.Bind(new(GroupRepository)).To(new(GroupRepositoryOnMemory))
+		binder.Bind(new(DashboardService))
+	})
+	if err != nil {
+		t.Fatalf("fatal: %v", err)
+	}
+	dashboardService := injector.Get(new(DashboardService)).(*DashboardService)
+	if dashboardService.ProjectService.FindUser() == nil {
+		t.Fatal("could not inject ProjectService just in time")
+	}
+	userRepository := injector.Get(new(UserRepositoryOnMemory)).(*UserRepositoryOnMemory)
+	if userRepository.FindAll() == nil {
+		t.Fatal("could not inject UserRepositoryOnMemory just in time")
+	}
+	if !injector.hasBinding(NewKey(new(UserRepositoryOnMemory))) {
+		t.Fatal("a just-in-time binding should be cached")
+	}
+}
+
+func Test_it_should_be_report_missing_dependency_of_just_in_time_binding(t *testing.T) {
+	injector, err := CreateInjector(func(binder Binder) {
+		binder.EnableJustInTimeBindings()
+	})
+	if err != nil {
+		t.Fatalf("fatal: %v", err)
+	}
+	_, err = injector.SafeGet(new(ProjectService))
+	if err == nil || !strings.Contains(err.Error(), "shot.UserRepository") {
+		t.Fatalf("Does not match. result: %v", err)
+	}
+	if _, err := injector.SafeGet(new(Store)); err == nil {
+		t.Fatal("an interface should not be bound just in time")
+	}
+}
+
+func Test_it_should_be_forbid_just_in_time_bindings(t *testing.T) {
+	injector, err := CreateInjector(func(binder Binder) {
+		binder.Bind(new(Store)).ToConstructor(NewStoreOnMemory)
+	})
+	if err != nil {
+		t.Fatalf("fatal: %v", err)
+	}
+	if _, err := injector.SafeGet(new(UserRepositoryOnMemory)); err == nil {
+		t.Fatal("just-in-time bindings should be opt-in")
+	}
+	parent, err := CreateInjector(func(binder Binder) {
+		binder.EnableJustInTimeBindings()
+		binder.Bind(new(Store)).ToConstructor(NewStoreOnMemory)
+	})
+	if err != nil {
+		t.Fatalf("fatal: %v", err)
+	}
+	_, err = parent.CreateChildInjector(func(binder Binder) {
+		binder.RequireExplicitBindings()
+		binder.Bind(new(DashboardService))
+	})
+	if err == nil || !strings.Contains(err.Error(), "shot.ProjectService") {
+		t.Fatalf("Does not match. result: %v", err)
+	}
+	child, err := parent.CreateChildInjector()
+	if err != nil {
+		t.Fatalf("fatal: %v", err)
+	}
+	if _, err := child.SafeGet(new(UserRepositoryOnMemory)); err != nil {
+		t.Fatalf("a child injector should inherit just-in-time bindings: %v", err)
+	}
+}
+
+type AuditLog interface {
+	Record(event string)
+}
+
+type AuditLogOnMemory struct {
+	Store  Store `inject:""`
+	events []string
+}
+
+func (log *AuditLogOnMemory) Record(event string) {
+	log.events = append(log.events, event)
+}
+
+type Clock interface {
+	Now() int
+}
+
+type FixedClock int
+
+func (clock FixedClock) Now() int {
+	return int(clock)
+}
+
+func NewFixedClock() Clock {
+	return FixedClock(42)
+}
+
+type ComplianceService struct {
+	Log   AuditLog `inject:""`
+	Clock Clock    `inject:""`
+}
+
+func init() {
+	ImplementedBy(new(AuditLog), new(AuditLogOnMemory))
+	ProvidedBy(new(Clock), NewFixedClock)
+}
+
+func Test_it_should_be_bind_default_implementations_just_in_time(t *testing.T) {
+	injector, err := CreateInjector(func(binder Binder) {
+		binder.EnableJustInTimeBindings()
+		binder.Bind(new(Store)).ToConstructor(NewStoreOnMemory)
+		binder.Bind(new(ComplianceService))
+	})
+	if err != nil {
+		t.Fatalf("fatal: %v", err)
+	}
+	complianceService := injector.Get(new(ComplianceService)).(*ComplianceService)
+	if log, ok := complianceService.Log.(*AuditLogOnMemory); !ok || log.Store == nil {
+		t.Fatalf("Does not match. result: %+v", complianceService.Log)
+	}
+	if complianceService.Clock.Now() != 42 {
+		t.Fatalf("Does not match. result: %v", complianceService.Clock.Now())
+	}
+}
+
+func Test_it_should_be_prefer_explicit_bindings_to_default_implementations(t *testing.T) {
+	clock := FixedClock(7)
+	injector, err := CreateInjector(func(binder Binder) {
+		binder.EnableJustInTimeBindings()
+		binder.Bind(new(Clock)).ToInstance(clock)
+	})
+	if err != nil {
+		t.Fatalf("fatal: %v", err)
+	}
+	if injector.Get(new(Clock)) != clock {
+		t.Fatalf("Does not match. result: %v", injector.Get(new(Clock)))
+	}
+	if _, err := injector.SafeGet(new(AuditLog)); err == nil || !strings.Contains(err.Error(), "shot.Store") {
+		t.Fatalf("Does not match. result: %v", err)
+	}
+}
+
+type EmbeddedDashboard struct {
+	Dash DashboardService `inject:""`
+}
+
+func Test_it_should_be_not_bind_value_struct_fields_just_in_time(t *testing.T) {
+	_, err := CreateInjector(func(binder Binder) {
+		binder.EnableJustInTimeBindings()
+		binder.Bind(new(EmbeddedDashboard))
+	})
+	if err == nil || !strings.Contains(err.Error(), "could not find a binding for shot.DashboardService") {
+		t.Fatalf("Does not match. result: %v", err)
+	}
+	injector, err := CreateInjector(func(binder Binder) {
+		binder.EnableJustInTimeBindings()
+	})
+	if err != nil {
+		t.Fatalf("fatal: %v", err)
+	}
+	_, err = injector.SafeGet(new(EmbeddedDashboard))
+	if err == nil || !strings.Contains(err.Error(), "could not find a binding for shot.DashboardService") {
+		t.Fatalf("Does not match. result: %v", err)
+	}
+}
+
+func Test_it_should_be_report_bindings_not_injectable_into_value_fields(t *testing.T) {
+	_, err := CreateInjector(func(binder Binder) {
+		binder.Bind(new(DashboardService)).ToInstance(&DashboardService{})
+		binder.Bind(new(EmbeddedDashboard))
+	})
+	if err == nil || !strings.Contains(err.Error(), "can't inject *shot.DashboardService into field Dash of shot.EmbeddedDashboard") {
+		t.Fatalf("Does not match. result: %v", err)
+	}
+}
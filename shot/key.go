@@ -1,16 +1,25 @@
 package shot
 
 import (
+	"fmt"
 	"reflect"
 )
 
 type Key interface {
 	Interface() interface{}
 	ReflectType() reflect.Type
+	Name() string
 }
 
 type key struct {
 	reflectType reflect.Type
+	name        string
+	element     *element
+}
+
+type element struct {
+	multibinding Key
+	mapKey       interface{}
 }
 
 func (key key) Interface() interface{} {
@@ -21,14 +30,59 @@ func (key key) ReflectType() reflect.Type {
 	return key.reflectType
 }
 
+func (key key) Name() string {
+	return key.name
+}
+
+func (key key) String() string {
+	name := key.reflectType.String()
+	if key.name != "" {
+		name = fmt.Sprintf("%s(name=%s)", name, key.name)
+	}
+	if key.element != nil && key.element.mapKey != nil {
+		name = fmt.Sprintf("%s(element %v of %v)", name, key.element.mapKey, key.element.multibinding)
+	} else if key.element != nil {
+		name = fmt.Sprintf("%s(element of %v)", name, key.element.multibinding)
+	}
+	return name
+}
+
 func NewKey(rawType interface{}) Key {
 	reflectType := reflect.TypeOf(rawType)
 	return NewKeyByType(reflectType)
 }
 
 func NewKeyByType(reflectType reflect.Type) Key {
-	if reflectType.Kind() == reflect.Ptr {
+	return NewNamedKeyByType(reflectType, "")
+}
+
+func NewNamedKey(rawType interface{}, name string) Key {
+	reflectType := reflect.TypeOf(rawType)
+	return NewNamedKeyByType(reflectType, name)
+}
+
+func NewNamedKeyByType(reflectType reflect.Type, name string) Key {
+	for reflectType.Kind() == reflect.Ptr {
 		reflectType = reflectType.Elem()
 	}
-	return key{reflectType}
+	return key{reflectType: reflectType, name: name}
+}
+
+func newElementKey(elementKey Key, multibinding Key, mapKey interface{}) Key {
+	return key{reflectType: elementKey.ReflectType(), name: elementKey.Name(), element: &element{multibinding, mapKey}}
+}
+
+func elementOf(k Key) *element {
+	if k, ok := k.(key); ok {
+		return k.element
+	}
+	return nil
+}
+
+func withName(k Key, name string) Key {
+	if k, ok := k.(key); ok {
+		k.name = name
+		return k
+	}
+	return NewNamedKeyByType(k.ReflectType(), name)
 }
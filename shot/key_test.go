@@ -28,3 +28,27 @@ func Test_Key(t *testing.T) {
 	}
 
 }
+
+func Test_NamedKey(t *testing.T) {
+
+	bindings := make(map[Key]interface{})
+
+	primary := NewNamedKey(new(User), "primary")
+	replica := NewNamedKey(new(User), "replica")
+
+	bindings[primary] = &user{"john"}
+	bindings[replica] = &user{"paul"}
+
+	if bindings[primary] == bindings[replica] {
+		t.Fatalf("Wrongs %v, %v", bindings[primary], bindings[replica])
+	}
+
+	if bindings[NewNamedKey(new(User), "primary")] != bindings[primary] {
+		t.Fatalf("Wrongs %v, %v", bindings[NewNamedKey(new(User), "primary")], bindings[primary])
+	}
+
+	if _, ok := bindings[NewKey(new(User))]; ok {
+		t.Fatal("an unnamed key should not match a named key")
+	}
+
+}
@@ -0,0 +1,145 @@
+package shot
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"sync"
+)
+
+type LifecycleHook func(ctx context.Context, instance interface{}) error
+
+type Starter interface {
+	Start(ctx context.Context) error
+}
+
+type Stopper interface {
+	Stop(ctx context.Context) error
+}
+
+type Initializer interface {
+	Init() error
+}
+
+type PostConstructor interface {
+	PostConstruct() error
+}
+
+func postConstruct(value interface{}) error {
+	switch value := value.(type) {
+	case Initializer:
+		return value.Init()
+	case PostConstructor:
+		return value.PostConstruct()
+	}
+	return nil
+}
+
+func newLifecycle() *lifecycle {
+	return &lifecycle{mux: &sync.Mutex{}}
+}
+
+type lifecycle struct {
+	mux       *sync.Mutex
+	instances []*lifecycleInstance
+	started   bool
+}
+
+type lifecycleInstance struct {
+	key      Key
+	instance interface{}
+	onStart  []LifecycleHook
+	onStop   []LifecycleHook
+}
+
+func (l *lifecycle) track(base *bindingBase, initialize initialize) initialize {
+	return func(res *resolution) (interface{}, error) {
+		value, err := initialize(res)
+		if err != nil {
+			return nil, err
+		}
+		instance := &lifecycleInstance{
+			key:      base.key,
+			instance: value,
+			onStart:  base.onStart,
+			onStop:   base.onStop,
+		}
+		l.mux.Lock()
+		l.instances = append(l.instances, instance)
+		started := l.started
+		l.mux.Unlock()
+		if started {
+			if err := instance.start(res.context()); err != nil {
+				return nil, newProvisionError(res.keys(), err)
+			}
+		}
+		return value, nil
+	}
+}
+
+func (l *lifecycle) start(ctx context.Context) error {
+	l.mux.Lock()
+	instances := append([]*lifecycleInstance{}, l.instances...)
+	l.started = true
+	l.mux.Unlock()
+	for _, instance := range instances {
+		if err := instance.start(ctx); err != nil {
+			return &LifecycleError{[]error{err}}
+		}
+	}
+	return nil
+}
+
+func (l *lifecycle) stop(ctx context.Context) error {
+	l.mux.Lock()
+	instances := l.instances
+	l.instances = nil
+	l.started = false
+	l.mux.Unlock()
+	var errs []error
+	for i := len(instances) - 1; i >= 0; i-- {
+		errs = append(errs, instances[i].stop(ctx)...)
+	}
+	if len(errs) > 0 {
+		return &LifecycleError{errs}
+	}
+	return nil
+}
+
+func (instance *lifecycleInstance) start(ctx context.Context) error {
+	for _, hook := range instance.onStart {
+		if err := hook(ctx, instance.instance); err != nil {
+			return fmt.Errorf("could not start %v: %w", instance.key, err)
+		}
+	}
+	if starter, ok := instance.instance.(Starter); ok {
+		if err := starter.Start(ctx); err != nil {
+			return fmt.Errorf("could not start %v: %w", instance.key, err)
+		}
+	}
+	return nil
+}
+
+func (instance *lifecycleInstance) stop(ctx context.Context) []error {
+	var errs []error
+	for _, hook := range instance.onStop {
+		if err := hook(ctx, instance.instance); err != nil {
+			errs = append(errs, fmt.Errorf("could not stop %v: %w", instance.key, err))
+		}
+	}
+	var err error
+	switch stopper := instance.instance.(type) {
+	case Stopper:
+		err = stopper.Stop(ctx)
+	case io.Closer:
+		err = stopper.Close()
+	}
+	if err != nil {
+		errs = append(errs, fmt.Errorf("could not stop %v: %w", instance.key, err))
+	}
+	return errs
+}
+
+func hasLifecycleHooks(binding binding) bool {
+	return len(binding.base().onStart) > 0 || len(binding.base().onStop) > 0
+}
@@ -0,0 +1,191 @@
+package shot
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+)
+
+type lifecycleEvents struct {
+	events []string
+}
+
+func (e *lifecycleEvents) add(event string) {
+	e.events = append(e.events, event)
+}
+
+type Pool struct {
+	Events *lifecycleEvents `inject:""`
+}
+
+func (pool *Pool) Start(ctx context.Context) error {
+	pool.Events.add("start pool")
+	return nil
+}
+
+func (pool *Pool) Stop(ctx context.Context) error {
+	pool.Events.add("stop pool")
+	return nil
+}
+
+type Listener struct {
+	Pool *Pool `inject:""`
+}
+
+var errListenerClosed = errors.New("listener is already closed")
+
+func (listener *Listener) Close() error {
+	listener.Pool.Events.add("close listener")
+	return errListenerClosed
+}
+
+func Test_it_should_be_stop_singletons_in_reverse_order(t *testing.T) {
+	events := &lifecycleEvents{}
+	injector, err := CreateInjector(func(binder Binder) {
+		binder.Bind(new(lifecycleEvents)).ToInstance(events)
+		binder.Bind(new(Pool)).In(SingletonInstance)
+		binder.Bind(new(Listener)).
+			OnStart(func(ctx context.Context, instance interface{}) error {
+				events.add("start listener")
+				return nil
+			}).
+			OnStop(func(ctx context.Context, instance interface{}) error {
+				events.add("stop listener")
+				return nil
+			}).
+			AsEagerSingleton()
+	})
+	if err != nil {
+		t.Fatalf("fatal: %v", err)
+	}
+	if err := injector.Start(context.Background()); err != nil {
+		t.Fatalf("fatal: %v", err)
+	}
+	err = injector.Close(context.Background())
+	var lifecycleErr *LifecycleError
+	if !errors.As(err, &lifecycleErr) || len(lifecycleErr.Errors) != 1 {
+		t.Fatalf("Does not match. result: %v", err)
+	}
+	if !errors.Is(err, errListenerClosed) {
+		t.Fatalf("Does not match. result: %v", err)
+	}
+	expected := "start pool,start listener,stop listener,close listener,stop pool"
+	if result := strings.Join(events.events, ","); result != expected {
+		t.Fatalf("Does not match. result: %s", result)
+	}
+}
+
+func Test_it_should_be_start_singletons_created_after_start(t *testing.T) {
+	events := &lifecycleEvents{}
+	injector, err := CreateInjector(func(binder Binder) {
+		binder.Bind(new(lifecycleEvents)).ToInstance(events)
+		binder.Bind(new(Pool)).In(SingletonInstance)
+	})
+	if err != nil {
+		t.Fatalf("fatal: %v", err)
+	}
+	if err := injector.Start(context.Background()); err != nil {
+		t.Fatalf("fatal: %v", err)
+	}
+	injector.Get(new(Pool))
+	if err := injector.Close(context.Background()); err != nil {
+		t.Fatalf("fatal: %v", err)
+	}
+	if result := strings.Join(events.events, ","); result != "start pool,stop pool" {
+		t.Fatalf("Does not match. result: %s", result)
+	}
+}
+
+func Test_it_should_be_report_lifecycle_hooks_of_unscoped_binding(t *testing.T) {
+	_, err := CreateInjector(func(binder Binder) {
+		binder.Bind(new(Pool)).OnStop(func(ctx context.Context, instance interface{}) error {
+			return nil
+		})
+	})
+	if err == nil || !strings.Contains(err.Error(), "lifecycle hooks require") {
+		t.Fatalf("Does not match. result: %v", err)
+	}
+}
+
+type Config struct {
+	Events *lifecycleEvents `inject:""`
+	valid  bool
+}
+
+func (config *Config) Init() error {
+	config.Events.add("init config")
+	if !config.valid {
+		return errors.New("invalid config")
+	}
+	return nil
+}
+
+type Server struct {
+	Pool *Pool
+}
+
+func NewServer(pool *Pool) *Server {
+	return &Server{Pool: pool}
+}
+
+func (server *Server) PostConstruct() error {
+	server.Pool.Events.add("post construct server")
+	return nil
+}
+
+func Test_it_should_be_call_post_construct_methods(t *testing.T) {
+	events := &lifecycleEvents{}
+	injector, err := CreateInjector(func(binder Binder) {
+		binder.Bind(new(lifecycleEvents)).ToInstance(events)
+		binder.Bind(new(Pool))
+		binder.Bind(new(Server)).ToConstructor(NewServer)
+		binder.Bind(new(Config))
+	})
+	if err != nil {
+		t.Fatalf("fatal: %v", err)
+	}
+	if _, err := injector.SafeGet(new(Server)); err != nil {
+		t.Fatalf("fatal: %v", err)
+	}
+	_, err = injector.SafeGet(new(Config))
+	if err == nil || err.Error() != "could not provide shot.Config: invalid config" {
+		t.Fatalf("Does not match. result: %v", err)
+	}
+	if result := strings.Join(events.events, ","); result != "post construct server,init config" {
+		t.Fatalf("Does not match. result: %s", result)
+	}
+}
+
+func Test_it_should_be_report_post_construct_error_of_eager_singleton(t *testing.T) {
+	_, err := CreateInjector(func(binder Binder) {
+		binder.Bind(new(lifecycleEvents)).ToInstance(&lifecycleEvents{})
+		binder.Bind(new(Config)).AsEagerSingleton()
+	})
+	if err == nil || !strings.Contains(err.Error(), "invalid config") {
+		t.Fatalf("Does not match. result: %v", err)
+	}
+}
+
+func NewUnavailableServer(pool *Pool) (*Server, error) {
+	return nil, errStoreUnavailable
+}
+
+func Test_it_should_be_stop_built_singletons_when_creation_fails(t *testing.T) {
+	events := &lifecycleEvents{}
+	_, err := CreateInjector(func(binder Binder) {
+		binder.Bind(new(lifecycleEvents)).ToInstance(events)
+		binder.Bind(new(Pool)).
+			OnStop(func(ctx context.Context, instance interface{}) error {
+				return errListenerClosed
+			}).
+			In(SingletonInstance)
+		binder.Bind(new(Server)).ToConstructor(NewUnavailableServer).AsEagerSingleton()
+	})
+	if !errors.Is(err, errStoreUnavailable) || !errors.Is(err, errListenerClosed) {
+		t.Fatalf("Does not match. result: %v", err)
+	}
+	if result := strings.Join(events.events, ","); result != "stop pool" {
+		t.Fatalf("Does not match. result: %s", result)
+	}
+}
@@ -0,0 +1,26 @@
+package shot
+
+import (
+	"reflect"
+	"runtime"
+	"strings"
+)
+
+type Module interface {
+	Configure(binder Binder)
+}
+
+func (configure Configure) Configure(binder Binder) {
+	configure(binder)
+}
+
+func moduleName(module Module) string {
+	switch module := module.(type) {
+	case Configure:
+		name := runtime.FuncForPC(reflect.ValueOf(module).Pointer()).Name()
+		return name[strings.LastIndex(name, "/")+1:]
+	case providersModule:
+		return reflect.TypeOf(module.providers).String()
+	}
+	return reflect.TypeOf(module).String()
+}
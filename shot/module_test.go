@@ -0,0 +1,107 @@
+package shot
+
+import (
+	"strings"
+	"testing"
+)
+
+type StoreModule struct{}
+
+func (module *StoreModule) Configure(binder Binder) {
+	binder.Bind(new(Store)).ToConstructor(NewStoreOnMemory).In(SingletonInstance)
+}
+
+type RepositoryModule struct {
+	storeModule *StoreModule
+}
+
+func (module RepositoryModule) Configure(binder Binder) {
+	binder.Install(module.storeModule)
+	binder.Bind(new(UserRepository)).ToConstructor(NewUserRepositoryOnMemory)
+	binder.Bind(new(GroupRepository)).ToConstructor(NewGroupRepositoryOnMemory)
+}
+
+func Test_it_should_be_install_modules(t *testing.T) {
+	storeModule := &StoreModule{}
+	injector, err := CreateInjector(func(binder Binder) {
+		binder.Install(RepositoryModule{storeModule}, storeModule)
+		binder.Install(RepositoryModule{storeModule})
+		binder.Bind(new(ProjectService))
+	})
+	if err != nil {
+		t.Fatalf("fatal: %v", err)
+	}
+	if len(injector.getBindings()) != 4 {
+		t.Fatalf("Does not match. result: %d", len(injector.getBindings()))
+	}
+	projectService := injector.Get(new(ProjectService)).(*ProjectService)
+	if projectService.FindUser() == nil {
+		t.Fatal("could not inject field of ProjectService")
+	}
+}
+
+func StoreConfigure(binder Binder) {
+	binder.Bind(new(Store)).ToConstructor(NewStoreOnMemory).In(SingletonInstance)
+}
+
+type TaggedModule struct {
+	tags interface{}
+}
+
+func (module TaggedModule) Configure(binder Binder) {
+	binder.Bind(new(UserRepository)).ToConstructor(NewUserRepositoryOnMemory)
+}
+
+func Test_it_should_be_install_the_same_configure_once(t *testing.T) {
+	injector, err := CreateInjector(func(binder Binder) {
+		binder.Install(Configure(StoreConfigure), Configure(StoreConfigure))
+		binder.Install(TaggedModule{[]int{1}})
+	}, StoreConfigure)
+	if err != nil {
+		t.Fatalf("fatal: %v", err)
+	}
+	if len(injector.getBindings()) != 2 {
+		t.Fatalf("Does not match. result: %d", len(injector.getBindings()))
+	}
+	_, err = CreateInjector(func(binder Binder) {
+		binder.Install(TaggedModule{[]int{1}}, TaggedModule{[]int{1}})
+		binder.Install(Configure(StoreConfigure))
+	})
+	if err == nil || !strings.Contains(err.Error(), "was already configured") {
+		t.Fatalf("a module holding an uncomparable value should be installed each time. result: %v", err)
+	}
+}
+
+func namedStoreModule(name string) Configure {
+	return func(binder Binder) {
+		binder.Bind(new(Store)).Named(name).ToConstructor(NewStoreOnMemory)
+	}
+}
+
+func Test_it_should_be_install_every_closure_of_a_module_factory(t *testing.T) {
+	injector, err := CreateInjector(namedStoreModule("a"), namedStoreModule("b"))
+	if err != nil {
+		t.Fatalf("fatal: %v", err)
+	}
+	for _, name := range []string{"a", "b"} {
+		if _, err := injector.SafeGetNamed(new(Store), name); err != nil {
+			t.Fatalf("fatal: %v", err)
+		}
+	}
+}
+
+func UserRepositoryModule(binder Binder) {
+	binder.Bind(new(UserRepository)).ToConstructor(NewUserRepositoryOnMemory)
+}
+
+func Test_it_should_be_report_a_module_declared_a_binding(t *testing.T) {
+	_, err := CreateInjector(func(binder Binder) {
+		binder.Install(Configure(UserRepositoryModule))
+	})
+	if err == nil {
+		t.Fatal("a missing dependency should be reported")
+	}
+	if !strings.Contains(err.Error(), "(binding shot.UserRepository in module shot.UserRepositoryModule)") {
+		t.Fatalf("Does not match. result: %v", err)
+	}
+}
@@ -0,0 +1,242 @@
+package shot
+
+import (
+	"fmt"
+	"reflect"
+)
+
+type SetBinder interface {
+	AddBinding() BindingBuilder
+}
+
+func NewSetBinder(binder Binder, elementType interface{}) SetBinder {
+	elementKey := NewKey(elementType)
+	setKey := NewKeyByType(reflect.SliceOf(targetType(elementType)))
+	if findMultibinding(binder, setKey) == nil {
+		binder.addBinding(newSetMultibinding(setKey, targetType(elementType)))
+	}
+	return &setBinder{
+		binder:     binder,
+		setKey:     setKey,
+		elementKey: elementKey,
+	}
+}
+
+func targetType(target interface{}) reflect.Type {
+	if reflectType := reflect.TypeOf(target); reflectType.Kind() == reflect.Ptr {
+		return reflectType.Elem()
+	}
+	return reflect.TypeOf(target)
+}
+
+type setBinder struct {
+	binder     Binder
+	setKey     Key
+	elementKey Key
+}
+
+func (binder *setBinder) AddBinding() BindingBuilder {
+	return newLinkedBindingBuilder(binder.binder, newElementKey(binder.elementKey, binder.setKey, nil))
+}
+
+type MapBinder interface {
+	AddBinding(key interface{}) BindingBuilder
+	PermitDuplicates() MapBinder
+}
+
+func NewMapBinder(binder Binder, keyType interface{}, valueType interface{}) MapBinder {
+	valueKey := NewKey(valueType)
+	mapType := reflect.MapOf(targetType(keyType), targetType(valueType))
+	mapKey := NewKeyByType(mapType)
+	if findMultibinding(binder, mapKey) == nil {
+		binder.addBinding(newMapMultibinding(mapKey, mapType))
+	}
+	return &mapBinder{
+		binder:   binder,
+		mapKey:   mapKey,
+		valueKey: valueKey,
+	}
+}
+
+type mapBinder struct {
+	binder   Binder
+	mapKey   Key
+	valueKey Key
+}
+
+func (binder *mapBinder) AddBinding(key interface{}) BindingBuilder {
+	return newLinkedBindingBuilder(binder.binder, newElementKey(binder.valueKey, binder.mapKey, key))
+}
+
+func (binder *mapBinder) PermitDuplicates() MapBinder {
+	findMultibinding(binder.binder, binder.mapKey).(*mapMultibinding).permitDuplicates = true
+	return binder
+}
+
+type multibinding interface {
+	binding
+	link(bindings []binding)
+}
+
+func findMultibinding(binder Binder, key Key) multibinding {
+	for _, binding := range binder.getBindingAll() {
+		if multibinding, ok := binding.(multibinding); ok && binding.getKey() == key {
+			return multibinding
+		}
+	}
+	return nil
+}
+
+func linkMultibindings(bindings []binding) {
+	for _, binding := range bindings {
+		if multibinding, ok := binding.(multibinding); ok {
+			multibinding.link(bindings)
+		}
+	}
+}
+
+func elementBindings(bindings []binding, key Key) []binding {
+	var elements []binding
+	for _, binding := range bindings {
+		if element := elementOf(binding.getKey()); element != nil && element.multibinding == key {
+			elements = append(elements, binding)
+		}
+	}
+	return elements
+}
+
+func newSetMultibinding(key Key, elementType reflect.Type) binding {
+	return &setMultibinding{
+		bindingBase: bindingBase{key: key, scope: NoScope},
+		elementType: elementType,
+	}
+}
+
+type setMultibinding struct {
+	bindingBase
+	elementType reflect.Type
+	elements    []Key
+}
+
+func (binding *setMultibinding) link(bindings []binding) {
+	binding.elements = nil
+	for _, element := range elementBindings(bindings, binding.key) {
+		binding.elements = append(binding.elements, element.getKey())
+	}
+}
+
+func (binding *setMultibinding) fill(injector Injector, options options) filledBinding {
+	return resolveBindingScope(injector, binding.base(), func(res *resolution) (interface{}, error) {
+		set := reflect.MakeSlice(reflect.SliceOf(binding.elementType), 0, len(binding.elements))
+		for _, key := range binding.elements {
+			value, err := resolveValue(injector, key, binding.elementType, res)
+			if err != nil {
+				return nil, err
+			}
+			set = reflect.Append(set, value)
+		}
+		return set.Interface(), nil
+	})
+}
+
+func (binding *setMultibinding) validate(options options) error {
+	return nil
+}
+
+func (binding *setMultibinding) providedType() reflect.Type {
+	return reflect.SliceOf(binding.elementType)
+}
+
+func (binding *setMultibinding) dependencies(options options) []dependency {
+	var dependencies []dependency
+	for i, key := range binding.elements {
+		dependencies = append(dependencies, dependency{
+			key:       key,
+			valueType: binding.elementType,
+			requiring: binding.providedType(),
+			index:     i,
+		})
+	}
+	return dependencies
+}
+
+func newMapMultibinding(key Key, mapType reflect.Type) binding {
+	return &mapMultibinding{
+		bindingBase: bindingBase{key: key, scope: NoScope},
+		mapType:     mapType,
+	}
+}
+
+type mapMultibinding struct {
+	bindingBase
+	mapType          reflect.Type
+	elements         []Key
+	permitDuplicates bool
+}
+
+func (binding *mapMultibinding) link(bindings []binding) {
+	binding.elements = nil
+	for _, element := range elementBindings(bindings, binding.key) {
+		binding.elements = append(binding.elements, element.getKey())
+	}
+	for _, other := range bindings {
+		if other, ok := other.(*mapMultibinding); ok && other.key == binding.key && other.permitDuplicates {
+			binding.permitDuplicates = true
+		}
+	}
+}
+
+func (binding *mapMultibinding) fill(injector Injector, options options) filledBinding {
+	return resolveBindingScope(injector, binding.base(), func(res *resolution) (interface{}, error) {
+		entries := reflect.MakeMapWithSize(binding.mapType, len(binding.elements))
+		for _, key := range binding.elements {
+			value, err := resolveValue(injector, key, binding.mapType.Elem(), res)
+			if err != nil {
+				return nil, err
+			}
+			entries.SetMapIndex(binding.mapKeyValue(key), value)
+		}
+		return entries.Interface(), nil
+	})
+}
+
+func (binding *mapMultibinding) mapKeyValue(key Key) reflect.Value {
+	value := reflect.New(binding.mapType.Key()).Elem()
+	if mapKey := elementOf(key).mapKey; mapKey != nil {
+		value.Set(reflect.ValueOf(mapKey))
+	}
+	return value
+}
+
+func (binding *mapMultibinding) validate(options options) error {
+	mapKeys := make(map[interface{}]bool)
+	for _, key := range binding.elements {
+		mapKey := elementOf(key).mapKey
+		mapKeyType := reflect.TypeOf(mapKey)
+		if mapKeyType == nil || !mapKeyType.AssignableTo(binding.mapType.Key()) || !mapKeyType.Comparable() {
+			return fmt.Errorf("a key %#v is not assignable to %v", mapKey, binding.mapType.Key())
+		}
+		if mapKeys[mapKey] && !binding.permitDuplicates {
+			return fmt.Errorf("a key %#v was already bound", mapKey)
+		}
+		mapKeys[mapKey] = true
+	}
+	return nil
+}
+
+func (binding *mapMultibinding) providedType() reflect.Type {
+	return binding.mapType
+}
+
+func (binding *mapMultibinding) dependencies(options options) []dependency {
+	var dependencies []dependency
+	for i, key := range binding.elements {
+		dependencies = append(dependencies, dependency{
+			key:       key,
+			valueType: binding.mapType.Elem(),
+			requiring: binding.mapType,
+			index:     i,
+		})
+	}
+	return dependencies
+}
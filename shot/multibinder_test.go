@@ -0,0 +1,224 @@
+package shot
+
+import (
+	"reflect"
+	"testing"
+)
+
+type Handler interface {
+	Name() string
+}
+
+type UserHandler struct {
+	UserRepository UserRepository `inject:""`
+}
+
+func (handler *UserHandler) Name() string {
+	return "user"
+}
+
+type GroupHandler struct {
+	GroupRepository GroupRepository
+}
+
+func (handler *GroupHandler) Name() string {
+	return "group"
+}
+
+func NewGroupHandler(groupRepository GroupRepository) *GroupHandler {
+	return &GroupHandler{groupRepository}
+}
+
+type HealthHandler struct{}
+
+func (handler HealthHandler) Name() string {
+	return "health"
+}
+
+type Router struct {
+	Handlers []Handler `inject:""`
+}
+
+func NewRouter(handlers []Handler) *Router {
+	return &Router{handlers}
+}
+
+func UserHandlerModule(binder Binder) {
+	binder.Bind(new(UserRepository)).ToConstructor(NewUserRepositoryOnMemory)
+	NewSetBinder(binder, new(Handler)).AddBinding().To(new(UserHandler)).In(SingletonInstance)
+}
+
+func GroupHandlerModule(binder Binder) {
+	binder.Bind(new(GroupRepository)).ToConstructor(NewGroupRepositoryOnMemory)
+	handlers := NewSetBinder(binder, new(Handler))
+	handlers.AddBinding().ToConstructor(NewGroupHandler)
+	handlers.AddBinding().ToInstance(HealthHandler{})
+}
+
+func handlerNames(handlers []Handler) []string {
+	var names []string
+	for _, handler := range handlers {
+		names = append(names, handler.Name())
+	}
+	return names
+}
+
+func Test_it_should_be_inject_a_set_of_bindings(t *testing.T) {
+	injector, err := CreateInjector(UserHandlerModule, GroupHandlerModule, func(binder Binder) {
+		binder.Bind(new(Store)).ToConstructor(NewStoreOnMemory)
+		binder.Bind(new(Router))
+	})
+	if err != nil {
+		t.Fatalf("fatal: %v", err)
+	}
+	router := injector.Get(new(Router)).(*Router)
+	if names := handlerNames(router.Handlers); !reflect.DeepEqual(names, []string{"user", "group", "health"}) {
+		t.Fatalf("Does not match. result: %v", names)
+	}
+	another := injector.Get(new([]Handler)).([]Handler)
+	if another[0] != router.Handlers[0] {
+		t.Fatal("an element should respect a scope of the binding")
+	}
+	if another[1] == router.Handlers[1] {
+		t.Fatal("an element of NoScope should be constructed every time")
+	}
+}
+
+func Test_it_should_be_inject_a_set_of_bindings_via_constructor(t *testing.T) {
+	injector, err := CreateInjector(func(binder Binder) {
+		NewSetBinder(binder, new(Handler))
+		binder.Bind(new(Router)).ToConstructor(NewRouter)
+	})
+	if err != nil {
+		t.Fatalf("fatal: %v", err)
+	}
+	router := injector.Get(new(Router)).(*Router)
+	if router.Handlers == nil || len(router.Handlers) != 0 {
+		t.Fatalf("Does not match. result: %v", router.Handlers)
+	}
+}
+
+func Test_it_should_be_report_an_invalid_element_of_set(t *testing.T) {
+	_, err := CreateInjector(func(binder Binder) {
+		NewSetBinder(binder, new(Handler)).AddBinding().To(new(StoreOnMemory))
+	})
+	if err == nil {
+		t.Fatal("an invalid element should be reported")
+	}
+}
+
+func Test_it_should_be_merge_sets_of_overridden_modules(t *testing.T) {
+	injector, err := CreateInjector(Override(UserHandlerModule).With(GroupHandlerModule), func(binder Binder) {
+		NewSetBinder(binder, new(Handler)).AddBinding().ToInstance(HealthHandler{})
+		binder.Bind(new(Store)).ToConstructor(NewStoreOnMemory)
+	})
+	if err != nil {
+		t.Fatalf("fatal: %v", err)
+	}
+	handlers := injector.Get(new([]Handler)).([]Handler)
+	if names := handlerNames(handlers); !reflect.DeepEqual(names, []string{"user", "group", "health", "health"}) {
+		t.Fatalf("Does not match. result: %v", names)
+	}
+}
+
+type Command interface {
+	Run() string
+}
+
+type MigrateCommand struct {
+	Store Store `inject:""`
+}
+
+func (command *MigrateCommand) Run() string {
+	return "migrate"
+}
+
+type ServeCommand struct{}
+
+func (command ServeCommand) Run() string {
+	return "serve"
+}
+
+func NewServeCommand() ServeCommand {
+	return ServeCommand{}
+}
+
+type CommandLine struct {
+	Commands map[string]Command `inject:""`
+}
+
+func Test_it_should_be_inject_a_map_of_bindings(t *testing.T) {
+	injector, err := CreateInjector(func(binder Binder) {
+		binder.Bind(new(Store)).ToConstructor(NewStoreOnMemory)
+		NewMapBinder(binder, new(string), new(Command)).AddBinding("migrate").To(new(MigrateCommand))
+		binder.Bind(new(CommandLine))
+	}, func(binder Binder) {
+		NewMapBinder(binder, new(string), new(Command)).AddBinding("serve").ToConstructor(NewServeCommand)
+	})
+	if err != nil {
+		t.Fatalf("fatal: %v", err)
+	}
+	commands := injector.Get(new(CommandLine)).(*CommandLine).Commands
+	if len(commands) != 2 || commands["migrate"].Run() != "migrate" || commands["serve"].Run() != "serve" {
+		t.Fatalf("Does not match. result: %v", commands)
+	}
+}
+
+func Test_it_should_be_report_duplicate_keys_of_map(t *testing.T) {
+	_, err := CreateInjector(func(binder Binder) {
+		commands := NewMapBinder(binder, new(string), new(Command))
+		commands.AddBinding("serve").ToInstance(ServeCommand{})
+		commands.AddBinding("serve").ToConstructor(NewServeCommand)
+	})
+	if err == nil {
+		t.Fatal("a duplicate key should be reported")
+	}
+
+	injector, err := CreateInjector(func(binder Binder) {
+		commands := NewMapBinder(binder, new(string), new(Command)).PermitDuplicates()
+		commands.AddBinding("serve").ToInstance(ServeCommand{})
+		commands.AddBinding("serve").ToConstructor(NewServeCommand)
+	})
+	if err != nil {
+		t.Fatalf("fatal: %v", err)
+	}
+	if commands := injector.Get(new(map[string]Command)).(map[string]Command); len(commands) != 1 {
+		t.Fatalf("Does not match. result: %v", commands)
+	}
+
+	_, err = CreateInjector(func(binder Binder) {
+		NewMapBinder(binder, new(string), new(Command)).AddBinding(1).ToInstance(ServeCommand{})
+	})
+	if err == nil {
+		t.Fatal("an invalid key should be reported")
+	}
+}
+
+type Plugin struct {
+	Name string
+}
+
+type PluginRegistry struct {
+	Plugins []*Plugin          `inject:""`
+	ByName  map[string]*Plugin `inject:""`
+}
+
+func Test_it_should_be_inject_sets_and_maps_of_struct_pointers(t *testing.T) {
+	injector, err := CreateInjector(func(binder Binder) {
+		plugins := NewSetBinder(binder, new(*Plugin))
+		plugins.AddBinding().ToInstance(&Plugin{"audit"})
+		plugins.AddBinding().To(new(Plugin))
+		NewMapBinder(binder, new(string), new(*Plugin)).AddBinding("audit").ToInstance(&Plugin{"audit"})
+		binder.Bind(new(PluginRegistry))
+	})
+	if err != nil {
+		t.Fatalf("fatal: %v", err)
+	}
+	registry := injector.Get(new(PluginRegistry)).(*PluginRegistry)
+	if len(registry.Plugins) != 2 || registry.Plugins[0].Name != "audit" || registry.Plugins[1] == nil {
+		t.Fatalf("Does not match. result: %+v", registry.Plugins)
+	}
+	if plugin := registry.ByName["audit"]; plugin == nil || plugin.Name != "audit" {
+		t.Fatalf("Does not match. result: %+v", registry.ByName)
+	}
+}
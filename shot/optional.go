@@ -0,0 +1,52 @@
+package shot
+
+import (
+	"reflect"
+	"strings"
+)
+
+type Optional[T any] struct {
+	Value   T
+	Present bool
+}
+
+func (optional Optional[T]) Get() (T, bool) {
+	return optional.Value, optional.Present
+}
+
+var optionalType = reflect.TypeOf(Optional[interface{}]{})
+
+func isOptional(valueType reflect.Type) bool {
+	return valueType.Kind() == reflect.Struct &&
+		valueType.PkgPath() == optionalType.PkgPath() &&
+		strings.HasPrefix(valueType.Name(), "Optional[")
+}
+
+func optionalKey(key Key) (Key, bool) {
+	if !isOptional(key.ReflectType()) {
+		return nil, false
+	}
+	return NewNamedKeyByType(key.ReflectType().Field(0).Type, key.Name()), true
+}
+
+func canResolve(injector Injector, key Key) bool {
+	if injector.hasBinding(key) {
+		return true
+	}
+	providedKey, ok := providerKey(key)
+	return ok && injector.hasBinding(providedKey)
+}
+
+func resolveOptionalValue(injector Injector, key Key, valueType reflect.Type, res *resolution) (reflect.Value, error) {
+	optional := reflect.New(valueType).Elem()
+	if !canResolve(injector, key) {
+		return optional, nil
+	}
+	value, err := resolveValue(injector, key, valueType.Field(0).Type, res)
+	if err != nil {
+		return reflect.Value{}, err
+	}
+	optional.Field(0).Set(value)
+	optional.Field(1).SetBool(true)
+	return optional, nil
+}
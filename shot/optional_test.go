@@ -0,0 +1,80 @@
+package shot
+
+import (
+	"strings"
+	"testing"
+)
+
+type MetricsSink interface {
+	Count(name string)
+}
+
+type MetricsSinkOnMemory struct {
+	counts map[string]int
+}
+
+func (sink *MetricsSinkOnMemory) Count(name string) {
+	sink.counts[name]++
+}
+
+type Worker struct {
+	Store   Store       `inject:""`
+	Metrics MetricsSink `inject:"optional"`
+}
+
+type Job struct {
+	Store   Store
+	Metrics Optional[MetricsSink]
+}
+
+func NewJob(store Store, metrics Optional[MetricsSink]) *Job {
+	return &Job{Store: store, Metrics: metrics}
+}
+
+func Test_it_should_be_leave_unbound_optional_dependencies_zero(t *testing.T) {
+	injector, err := CreateInjector(func(binder Binder) {
+		binder.Bind(new(Store)).ToConstructor(NewStoreOnMemory)
+		binder.Bind(new(Worker))
+		binder.Bind(new(Job)).ToConstructor(NewJob)
+	})
+	if err != nil {
+		t.Fatalf("fatal: %v", err)
+	}
+	worker := injector.Get(new(Worker)).(*Worker)
+	if worker.Store == nil || worker.Metrics != nil {
+		t.Fatalf("Does not match. result: %+v", worker)
+	}
+	job := injector.Get(new(Job)).(*Job)
+	if _, ok := job.Metrics.Get(); ok || job.Store == nil {
+		t.Fatalf("Does not match. result: %+v", job)
+	}
+}
+
+func Test_it_should_be_inject_bound_optional_dependencies(t *testing.T) {
+	sink := &MetricsSinkOnMemory{counts: make(map[string]int)}
+	injector, err := CreateInjector(func(binder Binder) {
+		binder.Bind(new(Store)).ToConstructor(NewStoreOnMemory)
+		binder.Bind(new(MetricsSink)).ToInstance(sink)
+		binder.Bind(new(Worker))
+		binder.Bind(new(Job)).ToConstructor(NewJob)
+	})
+	if err != nil {
+		t.Fatalf("fatal: %v", err)
+	}
+	if worker := injector.Get(new(Worker)).(*Worker); worker.Metrics != sink {
+		t.Fatalf("Does not match. result: %+v", worker)
+	}
+	job := injector.Get(new(Job)).(*Job)
+	if metrics, ok := job.Metrics.Get(); !ok || metrics != sink {
+		t.Fatalf("Does not match. result: %+v", job)
+	}
+}
+
+func Test_it_should_be_report_missing_required_dependency_next_to_optional_one(t *testing.T) {
+	_, err := CreateInjector(func(binder Binder) {
+		binder.Bind(new(Worker))
+	})
+	if err == nil || !strings.Contains(err.Error(), "shot.Store") || strings.Contains(err.Error(), "MetricsSink") {
+		t.Fatalf("Does not match. result: %v", err)
+	}
+}
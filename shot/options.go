@@ -0,0 +1,94 @@
+package shot
+
+type Option func(options *options)
+
+type TagMode int
+
+const (
+	TagRequired TagMode = iota
+	TagIgnored
+)
+
+type Stage int
+
+const (
+	Development Stage = iota
+	Production
+)
+
+type Logger interface {
+	Printf(format string, args ...interface{})
+}
+
+type options struct {
+	modules []Module
+	tagOnly bool
+	stage   Stage
+	strict  bool
+	jit     bool
+	logger  Logger
+}
+
+func newOptions(opts ...Option) options {
+	options := options{tagOnly: true}
+	for _, opt := range opts {
+		opt(&options)
+	}
+	return options
+}
+
+func (options options) inherit() options {
+	options.modules = nil
+	options.jit = false
+	return options
+}
+
+func (options options) logf(format string, args ...interface{}) {
+	if options.logger != nil {
+		options.logger.Printf(format, args...)
+	}
+}
+
+func WithModules(modules ...Module) Option {
+	return func(options *options) {
+		options.modules = append(options.modules, modules...)
+	}
+}
+
+func WithConfigures(configures ...Configure) Option {
+	return func(options *options) {
+		for _, configure := range configures {
+			options.modules = append(options.modules, configure)
+		}
+	}
+}
+
+func WithTagMode(mode TagMode) Option {
+	return func(options *options) {
+		options.tagOnly = mode == TagRequired
+	}
+}
+
+func WithStage(stage Stage) Option {
+	return func(options *options) {
+		options.stage = stage
+	}
+}
+
+func WithStrictValidation() Option {
+	return func(options *options) {
+		options.strict = true
+	}
+}
+
+func WithJIT() Option {
+	return func(options *options) {
+		options.jit = true
+	}
+}
+
+func WithLogger(logger Logger) Option {
+	return func(options *options) {
+		options.logger = logger
+	}
+}
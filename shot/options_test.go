@@ -0,0 +1,103 @@
+package shot
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+)
+
+type recordingLogger struct {
+	messages []string
+}
+
+func (logger *recordingLogger) Printf(format string, args ...interface{}) {
+	logger.messages = append(logger.messages, fmt.Sprintf(format, args...))
+}
+
+type MisconfiguredService struct {
+	Store Store `inject:"nmae=primary"`
+}
+
+func Test_it_should_be_create_injector_with_options(t *testing.T) {
+	logger := &recordingLogger{}
+	injector, err := New(
+		WithModules(ModuleFromProviders(&RepositoryProviders{})),
+		WithConfigures(func(binder Binder) {
+			binder.Bind(new(ProjectService))
+		}),
+		WithTagMode(TagIgnored),
+		WithJIT(),
+		WithLogger(logger),
+	)
+	if err == nil || !strings.Contains(err.Error(), "shot.GroupRepository") {
+		t.Fatalf("Does not match. result: %v", err)
+	}
+	injector, err = New(
+		WithModules(ModuleFromProviders(&RepositoryProviders{})),
+		WithConfigures(func(binder Binder) {
+			binder.Bind(new(GroupRepository)).ToConstructor(NewGroupRepositoryOnMemory)
+			binder.Bind(new(Store)).ToConstructor(NewStoreOnMemory)
+		}),
+		WithJIT(),
+		WithLogger(logger),
+	)
+	if err != nil {
+		t.Fatalf("fatal: %v", err)
+	}
+	if projectService := injector.Get(new(ProjectService)).(*ProjectService); projectService.FindGroup() == nil {
+		t.Fatal("could not inject ProjectService just in time")
+	}
+	expected := "shot: created injector with 4 bindings,shot: created just-in-time binding for shot.ProjectService"
+	if result := strings.Join(logger.messages, ","); result != expected {
+		t.Fatalf("Does not match. result: %s", result)
+	}
+}
+
+func Test_it_should_be_load_singletons_eagerly_in_production_stage(t *testing.T) {
+	var calls int
+	newStore := func() *StoreOnMemory {
+		calls++
+		return NewStoreOnMemory()
+	}
+	configure := func(binder Binder) {
+		binder.Bind(new(Store)).ToConstructor(newStore).In(SingletonInstance)
+	}
+	if _, err := New(WithConfigures(configure)); err != nil {
+		t.Fatalf("fatal: %v", err)
+	}
+	if calls != 0 {
+		t.Fatalf("Does not match. result: %d", calls)
+	}
+	if _, err := New(WithConfigures(configure), WithStage(Production)); err != nil {
+		t.Fatalf("fatal: %v", err)
+	}
+	if calls != 1 {
+		t.Fatalf("Does not match. result: %d", calls)
+	}
+}
+
+func Test_it_should_be_report_unknown_tag_options_with_strict_validation(t *testing.T) {
+	configure := func(binder Binder) {
+		binder.Bind(new(Store)).ToConstructor(NewStoreOnMemory)
+		binder.Bind(new(MisconfiguredService))
+	}
+	if _, err := New(WithConfigures(configure)); err != nil {
+		t.Fatalf("fatal: %v", err)
+	}
+	_, err := New(WithConfigures(configure), WithStrictValidation())
+	if err == nil || !strings.Contains(err.Error(), `unknown inject tag option "nmae=primary"`) {
+		t.Fatalf("Does not match. result: %v", err)
+	}
+	_, err = New(WithConfigures(func(binder Binder) {
+		binder.Bind(new(DashboardService))
+	}), WithJIT(), WithStrictValidation())
+	if err == nil || err.Error() != "just-in-time bindings can't be enabled with strict validation" {
+		t.Fatalf("Does not match. result: %v", err)
+	}
+	_, err = New(WithConfigures(func(binder Binder) {
+		binder.EnableJustInTimeBindings()
+	}), WithStrictValidation())
+	if err == nil || err.Error() != "just-in-time bindings can't be enabled with strict validation" {
+		t.Fatalf("Does not match. result: %v", err)
+	}
+}
@@ -0,0 +1,58 @@
+package shot
+
+type OverrideBuilder interface {
+	With(overrides ...Configure) Configure
+}
+
+func Override(configures ...Configure) OverrideBuilder {
+	return &overrideBuilder{configures}
+}
+
+type overrideBuilder struct {
+	configures []Configure
+}
+
+func (builder *overrideBuilder) With(overrides ...Configure) Configure {
+	return func(binder Binder) {
+		base := newBinder()
+		for _, configure := range builder.configures {
+			base.Install(configure)
+		}
+		overriding := newBinder()
+		for _, configure := range overrides {
+			overriding.Install(configure)
+		}
+		overridden := make(map[Key]bool)
+		for _, binding := range overriding.getBindingAll() {
+			overridden[binding.getKey()] = true
+		}
+		for _, binding := range base.getBindingAll() {
+			if !overridden[binding.getKey()] {
+				binder.addBinding(binding)
+			}
+		}
+		for _, binding := range overriding.getBindingAll() {
+			binder.addBinding(binding)
+		}
+		overriddenScopes := make(map[Scope]bool)
+		for _, scopeBinding := range overriding.getScopeBindings() {
+			overriddenScopes[scopeBinding.scope] = true
+		}
+		for _, scopeBinding := range base.getScopeBindings() {
+			if !overriddenScopes[scopeBinding.scope] {
+				binder.BindScope(scopeBinding.scope, scopeBinding.impl)
+			}
+		}
+		for _, scopeBinding := range overriding.getScopeBindings() {
+			binder.BindScope(scopeBinding.scope, scopeBinding.impl)
+		}
+		for _, policy := range []bindingPolicy{base.getBindingPolicy(), overriding.getBindingPolicy()} {
+			if policy.justInTime {
+				binder.EnableJustInTimeBindings()
+			}
+			if policy.explicit {
+				binder.RequireExplicitBindings()
+			}
+		}
+	}
+}
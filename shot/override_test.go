@@ -0,0 +1,90 @@
+package shot
+
+import (
+	"strings"
+	"testing"
+)
+
+type StoreOnTest struct {
+	StoreOnMemory
+}
+
+func ProductionModule(binder Binder) {
+	binder.Bind(new(Store)).ToConstructor(NewStoreOnMemory).In(SingletonInstance)
+	binder.Bind(new(UserRepository)).ToConstructor(NewUserRepositoryOnMemory)
+}
+
+func FakeStoreModule(binder Binder) {
+	binder.Bind(new(Store)).ToInstance(&StoreOnTest{StoreOnMemory{users: []string{"test-user"}}})
+}
+
+func Test_it_should_be_override_bindings(t *testing.T) {
+	injector, err := CreateInjector(Override(ProductionModule).With(FakeStoreModule))
+	if err != nil {
+		t.Fatalf("fatal: %v", err)
+	}
+	if _, ok := injector.Get(new(Store)).(*StoreOnTest); !ok {
+		t.Fatal("a binding for Store should be overridden")
+	}
+	userRepository := injector.Get(new(UserRepository)).(UserRepository)
+	if users := userRepository.FindAll(); len(users) != 1 || users[0] != "test-user" {
+		t.Fatalf("Does not match. result: %v", users)
+	}
+}
+
+func Test_it_should_be_report_duplicate_bindings(t *testing.T) {
+	_, err := CreateInjector(ProductionModule, FakeStoreModule)
+	if err == nil {
+		t.Fatal("a duplicate binding should be reported")
+	}
+	expected := "a binding for shot.Store (module shot.FakeStoreModule) was already configured in module shot.ProductionModule"
+	if !strings.Contains(err.Error(), expected) {
+		t.Fatalf("Does not match. result: %v", err)
+	}
+}
+
+func Test_it_should_be_override_scope_implementations(t *testing.T) {
+	production := &jobScope{instances: make(map[Key]interface{})}
+	fake := &jobScope{instances: make(map[Key]interface{})}
+	injector, err := CreateInjector(Override(func(binder Binder) {
+		binder.BindScope(PerJob, production)
+		binder.Bind(new(Store)).ToConstructor(NewStoreOnMemory).In(PerJob)
+	}).With(func(binder Binder) {
+		binder.BindScope(PerJob, fake)
+	}))
+	if err != nil {
+		t.Fatalf("fatal: %v", err)
+	}
+	injector.Get(new(Store))
+	if production.scoped != 0 || len(fake.instances) != 1 {
+		t.Fatalf("a scope implementation should be overridden. result: %+v, %+v", production, fake)
+	}
+}
+
+func FakeUserRepositoryModule(binder Binder) {
+	binder.Bind(new(UserRepository)).ToInstance(&UserRepositoryOnMemory{Store: &StoreOnTest{}})
+}
+
+func ProductionGroupModule(binder Binder) {
+	binder.Bind(new(GroupRepository)).ToConstructor(NewGroupRepositoryOnMemory)
+}
+
+func FakeGroupRepositoryModule(binder Binder) {
+	binder.Bind(new(GroupRepository)).ToInstance(&GroupRepositoryOnMemory{Store: &StoreOnTest{}})
+}
+
+func Test_it_should_be_install_every_override(t *testing.T) {
+	injector, err := CreateInjector(
+		Override(ProductionModule).With(FakeUserRepositoryModule),
+		Override(ProductionGroupModule).With(FakeGroupRepositoryModule),
+	)
+	if err != nil {
+		t.Fatalf("fatal: %v", err)
+	}
+	if _, ok := injector.Get(new(GroupRepository)).(*GroupRepositoryOnMemory).Store.(*StoreOnTest); !ok {
+		t.Fatal("a binding for GroupRepository should be overridden")
+	}
+	if _, ok := injector.Get(new(UserRepository)).(*UserRepositoryOnMemory).Store.(*StoreOnTest); !ok {
+		t.Fatal("a binding for UserRepository should be overridden")
+	}
+}
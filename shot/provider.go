@@ -0,0 +1,110 @@
+package shot
+
+import (
+	"context"
+	"reflect"
+	"strings"
+)
+
+type Provider[T any] func() (T, error)
+
+func (provider Provider[T]) Get() (T, error) {
+	return provider()
+}
+
+type ContextProvider[T any] func(ctx context.Context) (T, error)
+
+func (provider ContextProvider[T]) Get(ctx context.Context) (T, error) {
+	return provider(ctx)
+}
+
+type Lazy[T any] func() (T, error)
+
+func (lazy Lazy[T]) Get() (T, error) {
+	return lazy()
+}
+
+var (
+	lazyType    = reflect.TypeOf(Lazy[interface{}](nil))
+	contextType = reflect.TypeOf((*context.Context)(nil)).Elem()
+)
+
+func isLazy(valueType reflect.Type) bool {
+	return valueType.PkgPath() == lazyType.PkgPath() && strings.HasPrefix(valueType.Name(), "Lazy[")
+}
+
+func providerKey(key Key) (Key, bool) {
+	providerType := key.ReflectType()
+	if providerType.Kind() != reflect.Func {
+		return nil, false
+	}
+	switch {
+	case providerType.NumIn() == 0:
+	case providerType.NumIn() == 1 && providerType.In(0) == contextType:
+	default:
+		return nil, false
+	}
+	switch {
+	case providerType.NumOut() == 1:
+	case providerType.NumOut() == 2 && providerType.Out(1) == errorType:
+	default:
+		return nil, false
+	}
+	return NewNamedKeyByType(providerType.Out(0), key.Name()), true
+}
+
+func newProviderValue(injector Injector, providerType reflect.Type, key Key, res *resolution) reflect.Value {
+	// Only a resolution still in progress is kept, for cycle detection and its context.
+	// A provider called later resolves against the context it is given, if any.
+	resolve := func(ctx context.Context) (interface{}, error) {
+		active := res.active()
+		if ctx == nil {
+			return injector.getByKey(key, active)
+		}
+		scoped := active.withContext(ctx)
+		defer scoped.finish()
+		return injector.getByKey(key, scoped)
+	}
+	get := func(args []reflect.Value) (interface{}, error) {
+		if len(args) == 1 {
+			ctx, _ := args[0].Interface().(context.Context)
+			return resolve(ctx)
+		}
+		return resolve(nil)
+	}
+	if isLazy(providerType) {
+		memoized := memoize(func() (interface{}, error) {
+			return resolve(nil)
+		})
+		get = func([]reflect.Value) (interface{}, error) {
+			return memoized()
+		}
+	}
+	return reflect.MakeFunc(providerType, func(args []reflect.Value) []reflect.Value {
+		value, err := get(args)
+		result := reflect.New(providerType.Out(0)).Elem()
+		if err == nil && value != nil {
+			result.Set(reflect.ValueOf(value))
+		}
+		if providerType.NumOut() == 1 {
+			if err != nil {
+				panic(err)
+			}
+			return []reflect.Value{result}
+		}
+		resultErr := reflect.Zero(errorType)
+		if err != nil {
+			resultErr = reflect.ValueOf(&err).Elem()
+		}
+		return []reflect.Value{result, resultErr}
+	})
+}
+
+func memoize(get func() (interface{}, error)) func() (interface{}, error) {
+	s := newSingletonValue(func(*resolution) (interface{}, error) {
+		return get()
+	})
+	return func() (interface{}, error) {
+		return s.get(nil)
+	}
+}
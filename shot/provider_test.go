@@ -0,0 +1,189 @@
+package shot
+
+import (
+	"context"
+	"strings"
+	"testing"
+)
+
+type ReportService struct {
+	Store          Provider[Store]           `inject:""`
+	UserRepository func() UserRepository     `inject:""`
+	ProjectService Provider[*ProjectService] `inject:""`
+}
+
+type LazyProjectService struct {
+	Reports func() (*ReportService, error) `inject:""`
+}
+
+func NewReportServiceWithProviders(store func() (Store, error), userRepository func() UserRepository) *ReportService {
+	return &ReportService{Store: store, UserRepository: userRepository}
+}
+
+func Test_it_should_be_inject_providers(t *testing.T) {
+	injector, err := CreateInjector(func(binder Binder) {
+		binder.Bind(new(Store)).ToConstructor(NewStoreOnMemory).In(SingletonInstance)
+		binder.Bind(new(UserRepository)).ToConstructor(NewUserRepositoryOnMemory)
+		binder.Bind(new(GroupRepository)).ToConstructor(NewGroupRepositoryOnMemory)
+		binder.Bind(new(ProjectService))
+		binder.Bind(new(ReportService))
+	})
+	if err != nil {
+		t.Fatalf("fatal: %v", err)
+	}
+	reportService := injector.Get(new(ReportService)).(*ReportService)
+	store, err := reportService.Store.Get()
+	if err != nil {
+		t.Fatalf("fatal: %v", err)
+	}
+	if another, _ := reportService.Store(); store != another {
+		t.Fatal("a provider should respect a scope of the binding")
+	}
+	if reportService.UserRepository() == reportService.UserRepository() {
+		t.Fatal("a provider should provide a new instance of NoScope binding")
+	}
+	projectService, err := reportService.ProjectService.Get()
+	if err != nil || projectService.FindUser() == nil {
+		t.Fatalf("could not provide a ProjectService: %v", err)
+	}
+}
+
+func Test_it_should_be_inject_providers_via_constructor(t *testing.T) {
+	injector, err := CreateInjector(func(binder Binder) {
+		binder.Bind(new(Store)).ToConstructor(NewStoreOnMemory)
+		binder.Bind(new(UserRepository)).ToConstructor(NewUserRepositoryOnMemory)
+		binder.Bind(new(ReportService)).ToConstructor(NewReportServiceWithProviders)
+	})
+	if err != nil {
+		t.Fatalf("fatal: %v", err)
+	}
+	reportService := injector.Get(new(ReportService)).(*ReportService)
+	if reportService.UserRepository().FindAll() == nil {
+		t.Fatal("could not provide a UserRepository")
+	}
+}
+
+func Test_it_should_be_break_a_cycle_via_provider(t *testing.T) {
+	injector, err := CreateInjector(func(binder Binder) {
+		binder.Bind(new(LazyProjectService)).In(SingletonInstance)
+		binder.Bind(new(ReportService)).ToConstructor(func(projectService *LazyProjectService) *ReportService {
+			return &ReportService{}
+		})
+	})
+	if err != nil {
+		t.Fatalf("fatal: %v", err)
+	}
+	projectService := injector.Get(new(LazyProjectService)).(*LazyProjectService)
+	if _, err := projectService.Reports(); err != nil {
+		t.Fatalf("fatal: %v", err)
+	}
+}
+
+func Test_it_should_be_detect_a_cycle_via_provider(t *testing.T) {
+	injector, err := CreateInjector(func(binder Binder) {
+		binder.Bind(new(LazyProjectService)).ToConstructor(func(reports func() (*ReportService, error)) (*LazyProjectService, error) {
+			_, err := reports()
+			return &LazyProjectService{reports}, err
+		})
+		binder.Bind(new(ReportService)).ToConstructor(func(projectService *LazyProjectService) *ReportService {
+			return &ReportService{}
+		})
+	})
+	if err != nil {
+		t.Fatalf("fatal: %v", err)
+	}
+	_, err = injector.SafeGet(new(LazyProjectService))
+	if err == nil || !strings.Contains(err.Error(), "cycle: shot.LazyProjectService -> shot.ReportService -> shot.LazyProjectService") {
+		t.Fatalf("Does not match. result: %v", err)
+	}
+}
+
+func Test_it_should_be_report_a_provider_without_binding(t *testing.T) {
+	_, err := CreateInjector(func(binder Binder) {
+		binder.Bind(new(LazyProjectService))
+	})
+	if err == nil {
+		t.Fatal("a provider without binding should be reported")
+	}
+}
+
+type HeavyClient struct {
+	connections int
+}
+
+type AuditService struct {
+	Client Lazy[*HeavyClient] `inject:""`
+}
+
+func Test_it_should_be_inject_lazy_dependencies(t *testing.T) {
+	var constructed int
+	injector, err := CreateInjector(func(binder Binder) {
+		binder.Bind(new(HeavyClient)).ToConstructor(func() *HeavyClient {
+			constructed++
+			return &HeavyClient{}
+		})
+		binder.Bind(new(AuditService)).AsEagerSingleton()
+	})
+	if err != nil {
+		t.Fatalf("fatal: %v", err)
+	}
+	if constructed != 0 {
+		t.Fatalf("a lazy dependency should not be constructed until Get. result: %d", constructed)
+	}
+	auditService := injector.Get(new(AuditService)).(*AuditService)
+	client, err := auditService.Client.Get()
+	if err != nil {
+		t.Fatalf("fatal: %v", err)
+	}
+	if another, _ := auditService.Client.Get(); another != client || constructed != 1 {
+		t.Fatalf("a lazy dependency should be memoized. result: %d", constructed)
+	}
+}
+
+func Test_it_should_be_memoize_lazy_dependencies_per_holder(t *testing.T) {
+	injector, err := CreateInjector(func(binder Binder) {
+		binder.Bind(new(HeavyClient))
+		binder.Bind(new(AuditService))
+	})
+	if err != nil {
+		t.Fatalf("fatal: %v", err)
+	}
+	client, _ := injector.Get(new(AuditService)).(*AuditService).Client.Get()
+	another, _ := injector.Get(new(AuditService)).(*AuditService).Client.Get()
+	if client == another {
+		t.Fatal("a lazy dependency should be memoized per holder")
+	}
+}
+
+type RequestStores struct {
+	Store    Provider[Store]        `inject:""`
+	StoreCtx ContextProvider[Store] `inject:""`
+}
+
+func Test_it_should_be_not_pin_the_context_of_the_first_request_to_providers(t *testing.T) {
+	injector, err := CreateInjector(func(binder Binder) {
+		binder.Bind(new(Store)).ToConstructor(NewStoreOnMemory).In(RequestScope)
+		binder.Bind(new(RequestStores)).In(SingletonInstance)
+	})
+	if err != nil {
+		t.Fatalf("fatal: %v", err)
+	}
+	first := EnterScope(context.Background())
+	stores := injector.GetCtx(first, NewKey(new(RequestStores))).(*RequestStores)
+	firstStore := injector.GetCtx(first, NewKey(new(Store)))
+
+	second := EnterScope(context.Background())
+	if _, err := injector.SafeGetCtx(second, NewKey(new(RequestStores))); err != nil {
+		t.Fatalf("fatal: %v", err)
+	}
+	if _, err := stores.Store.Get(); err == nil {
+		t.Fatal("a provider called out of a resolution should not reuse the context of the first request")
+	}
+	secondStore, err := stores.StoreCtx.Get(second)
+	if err != nil {
+		t.Fatalf("fatal: %v", err)
+	}
+	if secondStore == firstStore || secondStore != injector.GetCtx(second, NewKey(new(Store))) {
+		t.Fatal("a context provider should resolve in the request scope of the given context")
+	}
+}
@@ -0,0 +1,31 @@
+package shot
+
+import (
+	"reflect"
+	"strings"
+)
+
+const providerMethodPrefix = "Provide"
+
+func ModuleFromProviders(providers interface{}) Module {
+	return providersModule{providers}
+}
+
+type providersModule struct {
+	providers interface{}
+}
+
+func (module providersModule) Configure(binder Binder) {
+	providersValue := reflect.ValueOf(module.providers)
+	providersType := providersValue.Type()
+	for i := 0; i < providersType.NumMethod(); i++ {
+		if !strings.HasPrefix(providersType.Method(i).Name, providerMethodPrefix) {
+			continue
+		}
+		method := providersValue.Method(i)
+		if method.Type().NumOut() == 0 {
+			continue
+		}
+		newLinkedBindingBuilder(binder, NewKeyByType(method.Type().Out(0))).ToConstructor(method.Interface())
+	}
+}
@@ -0,0 +1,57 @@
+package shot
+
+import (
+	"strings"
+	"testing"
+)
+
+type RepositoryProviders struct {
+	calls int
+}
+
+func (providers *RepositoryProviders) ProvideStore() *StoreOnMemory {
+	providers.calls++
+	return NewStoreOnMemory()
+}
+
+func (providers *RepositoryProviders) ProvideUserRepository(store *StoreOnMemory) (UserRepository, error) {
+	return NewUserRepositoryOnMemory(store), nil
+}
+
+func (providers *RepositoryProviders) String() string {
+	return "repository providers"
+}
+
+type BrokenProviders struct{}
+
+func (providers BrokenProviders) ProvideNothing() {}
+
+func (providers BrokenProviders) ProvideGroupRepository(store Store) GroupRepository {
+	return NewGroupRepositoryOnMemory(store)
+}
+
+func Test_it_should_be_bind_provider_methods_of_module(t *testing.T) {
+	providers := &RepositoryProviders{}
+	injector, err := CreateInjector(func(binder Binder) {
+		binder.Install(ModuleFromProviders(providers), ModuleFromProviders(providers))
+	})
+	if err != nil {
+		t.Fatalf("fatal: %v", err)
+	}
+	userRepository := Get[UserRepository](injector)
+	if userRepository == nil || userRepository.FindAll() == nil {
+		t.Fatal("could not inject UserRepository")
+	}
+	if providers.calls != 1 {
+		t.Fatalf("Does not match. result: %d", providers.calls)
+	}
+}
+
+func Test_it_should_be_report_missing_dependency_of_provider_method(t *testing.T) {
+	_, err := CreateInjector(func(binder Binder) {
+		binder.Install(ModuleFromProviders(BrokenProviders{}))
+	})
+	if err == nil || !strings.Contains(err.Error(), "shot.Store") || !strings.Contains(err.Error(), "shot.BrokenProviders") {
+		t.Fatalf("Does not match. result: %v", err)
+	}
+}
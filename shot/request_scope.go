@@ -0,0 +1,55 @@
+package shot
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sync"
+)
+
+type requestScopeContextKey struct{}
+
+type requestScope struct {
+	mux        *sync.Mutex
+	singletons map[*requestScopedBinding]*singleton
+}
+
+func EnterScope(ctx context.Context) context.Context {
+	return context.WithValue(ctx, requestScopeContextKey{}, &requestScope{
+		mux:        &sync.Mutex{},
+		singletons: make(map[*requestScopedBinding]*singleton),
+	})
+}
+
+func RequestScopeMiddleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		next.ServeHTTP(w, r.WithContext(EnterScope(r.Context())))
+	})
+}
+
+func (scope *requestScope) singleton(binding *requestScopedBinding) *singleton {
+	scope.mux.Lock()
+	defer scope.mux.Unlock()
+	s, ok := scope.singletons[binding]
+	if !ok {
+		s = newSingletonValue(binding.initialize)
+		scope.singletons[binding] = s
+	}
+	return s
+}
+
+func newRequestScopedBinding(initialize initialize) filledBinding {
+	return &requestScopedBinding{initialize}
+}
+
+type requestScopedBinding struct {
+	initialize initialize
+}
+
+func (binding *requestScopedBinding) get(res *resolution) (interface{}, error) {
+	scope, ok := res.context().Value(requestScopeContextKey{}).(*requestScope)
+	if !ok {
+		return nil, newProvisionError(res.keys(), fmt.Errorf("%v is out of a request scope; use shot.EnterScope", RequestScope))
+	}
+	return scope.singleton(binding).get(res)
+}
@@ -0,0 +1,65 @@
+package shot
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func newRequestScopedInjector(t *testing.T) Injector {
+	injector, err := CreateInjector(func(binder Binder) {
+		binder.Bind(new(Store)).ToConstructor(NewStoreOnMemory).In(RequestScope)
+		binder.Bind(new(UserRepository)).ToConstructor(NewUserRepositoryOnMemory)
+		binder.Bind(new(GroupRepository)).ToConstructor(NewGroupRepositoryOnMemory)
+	})
+	if err != nil {
+		t.Fatalf("fatal: %v", err)
+	}
+	return injector
+}
+
+func Test_it_should_be_cache_instances_per_request_scope(t *testing.T) {
+	injector := newRequestScopedInjector(t)
+
+	ctx := EnterScope(context.Background())
+	userRepository := injector.GetCtx(ctx, NewKey(new(UserRepository))).(*UserRepositoryOnMemory)
+	groupRepository := injector.GetCtx(ctx, NewKey(new(GroupRepository))).(*GroupRepositoryOnMemory)
+	if userRepository.Store != groupRepository.Store {
+		t.Fatal("an instance should be shared in a request scope")
+	}
+
+	another := injector.GetCtx(EnterScope(context.Background()), NewKey(new(Store)))
+	if another == userRepository.Store {
+		t.Fatal("an instance should not be shared between request scopes")
+	}
+
+	if _, err := injector.SafeGetCtx(context.Background(), NewKey(new(Store))); err == nil {
+		t.Fatal("an error should be returned out of a request scope")
+	}
+	if _, err := injector.SafeGet(new(Store)); err == nil {
+		t.Fatal("an error should be returned out of a request scope")
+	}
+}
+
+func Test_it_should_be_enter_a_request_scope_per_request(t *testing.T) {
+	injector := newRequestScopedInjector(t)
+
+	var stores []interface{}
+	handler := RequestScopeMiddleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		store, err := injector.SafeGetCtx(r.Context(), NewKey(new(Store)))
+		if err != nil {
+			t.Fatalf("fatal: %v", err)
+		}
+		if store != injector.GetCtx(r.Context(), NewKey(new(Store))) {
+			t.Fatal("an instance should be shared in a request")
+		}
+		stores = append(stores, store)
+	}))
+	handler.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/", nil))
+	handler.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/", nil))
+
+	if len(stores) != 2 || stores[0] == stores[1] {
+		t.Fatal("an instance should not be shared between requests")
+	}
+}
@@ -0,0 +1,74 @@
+package shot
+
+import (
+	"context"
+	"sync/atomic"
+)
+
+type resolution struct {
+	parent *resolution
+	key    Key
+	ctx    context.Context
+	done   int32
+}
+
+func newResolution(ctx context.Context) *resolution {
+	return &resolution{ctx: ctx}
+}
+
+func (res *resolution) push(key Key) *resolution {
+	return &resolution{parent: res, key: key, ctx: res.context()}
+}
+
+func (res *resolution) withContext(ctx context.Context) *resolution {
+	return &resolution{parent: res, ctx: ctx}
+}
+
+func (res *resolution) finish() {
+	atomic.StoreInt32(&res.done, 1)
+}
+
+func (res *resolution) active() *resolution {
+	for r := res; r != nil; r = r.parent {
+		if atomic.LoadInt32(&r.done) == 0 {
+			return r
+		}
+	}
+	return nil
+}
+
+func (res *resolution) context() context.Context {
+	if res == nil || res.ctx == nil {
+		return context.Background()
+	}
+	return res.ctx
+}
+
+func (res *resolution) contains(key Key) bool {
+	for r := res; r != nil; r = r.parent {
+		if r.key == key {
+			return true
+		}
+	}
+	return false
+}
+
+func (res *resolution) keys() []Key {
+	var keys []Key
+	for r := res; r != nil; r = r.parent {
+		if r.key != nil {
+			keys = append([]Key{r.key}, keys...)
+		}
+	}
+	return keys
+}
+
+func (res *resolution) cycle(key Key) []Key {
+	keys := res.keys()
+	for i, k := range keys {
+		if k == key {
+			return append(keys[i:], key)
+		}
+	}
+	return append(keys, key)
+}
@@ -1,17 +1,50 @@
 package shot
 
+import "fmt"
+
 type Scope int
 
 func (scope Scope) String() string {
-	names := [...]string{"NoScope", "SingletonInstance", "EagerSingleton"}
-	if scope < NoScope || scope > EagerSingleton {
-		return "Unknown"
+	names := [...]string{"NoScope", "SingletonInstance", "EagerSingleton", "RequestScope"}
+	if !scope.builtIn() {
+		return fmt.Sprintf("Scope(%d)", int(scope))
 	}
 	return names[scope]
 }
 
+func (scope Scope) builtIn() bool {
+	return scope >= NoScope && scope <= RequestScope
+}
+
 const (
 	NoScope           Scope = 0
 	SingletonInstance Scope = 1
 	EagerSingleton    Scope = 2
+	RequestScope      Scope = 3
 )
+
+type ScopeImpl interface {
+	Scope(key Key, unscoped Provider[interface{}]) Provider[interface{}]
+}
+
+type scopeBinding struct {
+	scope  Scope
+	impl   ScopeImpl
+	source string
+}
+
+func newCustomScopedBinding(key Key, impl ScopeImpl, initialize initialize) filledBinding {
+	return &customScopedBinding{key, impl, initialize}
+}
+
+type customScopedBinding struct {
+	key        Key
+	impl       ScopeImpl
+	initialize initialize
+}
+
+func (binding *customScopedBinding) get(res *resolution) (interface{}, error) {
+	return binding.impl.Scope(binding.key, func() (interface{}, error) {
+		return binding.initialize(res)
+	}).Get()
+}
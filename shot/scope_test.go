@@ -1,6 +1,12 @@
 package shot
 
-import "testing"
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+)
 
 func Test_Scope(t *testing.T) {
 	if NoScope.String() != "NoScope" {
@@ -13,4 +19,138 @@ func Test_Scope(t *testing.T) {
 	if EagerSingleton.String() != "EagerSingleton" {
 		t.Fatalf("Does not match. result: %s", EagerSingleton.String())
 	}
+	if RequestScope.String() != "RequestScope" {
+		t.Fatalf("Does not match. result: %s", RequestScope.String())
+	}
+}
+
+const PerJob Scope = 100
+
+type jobScope struct {
+	scoped    int
+	instances map[Key]interface{}
+}
+
+func (scope *jobScope) Scope(key Key, unscoped Provider[interface{}]) Provider[interface{}] {
+	scope.scoped++
+	return func() (interface{}, error) {
+		if instance, ok := scope.instances[key]; ok {
+			return instance, nil
+		}
+		instance, err := unscoped.Get()
+		if err != nil {
+			return nil, err
+		}
+		scope.instances[key] = instance
+		return instance, nil
+	}
+}
+
+func (scope *jobScope) finish() {
+	scope.instances = make(map[Key]interface{})
+}
+
+func Test_CustomScope(t *testing.T) {
+	if PerJob.String() != "Scope(100)" {
+		t.Fatalf("Does not match. result: %s", PerJob.String())
+	}
+
+	scope := &jobScope{instances: make(map[Key]interface{})}
+	injector, err := CreateInjector(func(binder Binder) {
+		binder.BindScope(PerJob, scope)
+		binder.Bind(new(Store)).ToConstructor(NewStoreOnMemory).In(PerJob)
+		binder.Bind(new(UserRepository)).ToConstructor(NewUserRepositoryOnMemory)
+	})
+	if err != nil {
+		t.Fatalf("fatal: %v", err)
+	}
+	store := injector.Get(new(Store))
+	if injector.Get(new(UserRepository)).(*UserRepositoryOnMemory).Store != store {
+		t.Fatal("an instance should be shared in a custom scope")
+	}
+	if scope.scoped != 2 {
+		t.Fatalf("Does not match. result: %d", scope.scoped)
+	}
+	scope.finish()
+	if injector.Get(new(Store)) == store {
+		t.Fatal("an instance should not be shared after a custom scope is finished")
+	}
+}
+
+func Test_it_should_be_report_invalid_scopes(t *testing.T) {
+	cases := map[string]Configure{
+		"unbound": func(binder Binder) {
+			binder.Bind(new(Store)).ToConstructor(NewStoreOnMemory).In(PerJob)
+		},
+		"built-in": func(binder Binder) {
+			binder.BindScope(SingletonInstance, &jobScope{})
+		},
+		"duplicate": func(binder Binder) {
+			binder.BindScope(PerJob, &jobScope{})
+			binder.BindScope(PerJob, &jobScope{})
+		},
+	}
+	for name, configure := range cases {
+		if _, err := CreateInjector(configure); err == nil {
+			t.Fatalf("an invalid scope should be reported: %s", name)
+		}
+	}
+}
+
+func Test_it_should_be_resolve_request_scoped_dependencies_in_custom_scope(t *testing.T) {
+	scope := &jobScope{instances: make(map[Key]interface{})}
+	injector, err := CreateInjector(func(binder Binder) {
+		binder.BindScope(PerJob, scope)
+		binder.Bind(new(Store)).ToConstructor(NewStoreOnMemory).In(RequestScope)
+		binder.Bind(new(UserRepository)).ToConstructor(NewUserRepositoryOnMemory).In(PerJob)
+	})
+	if err != nil {
+		t.Fatalf("fatal: %v", err)
+	}
+	ctx := EnterScope(context.Background())
+	userRepository, err := injector.SafeGetCtx(ctx, NewKey(new(UserRepository)))
+	if err != nil {
+		t.Fatalf("fatal: %v", err)
+	}
+	if userRepository.(*UserRepositoryOnMemory).Store != injector.GetCtx(ctx, NewKey(new(Store))) {
+		t.Fatal("a custom scope should resolve dependencies in the request scope of the caller")
+	}
+}
+
+type goroutineScope struct{}
+
+func (scope goroutineScope) Scope(key Key, unscoped Provider[interface{}]) Provider[interface{}] {
+	return unscoped
+}
+
+func Test_it_should_be_resolve_custom_scoped_bindings_concurrently(t *testing.T) {
+	var calls int32
+	var injector Injector
+	newStore := func() (*StoreOnMemory, error) {
+		if atomic.AddInt32(&calls, 1) > 1 {
+			return NewStoreOnMemory(), nil
+		}
+		done := make(chan error)
+		go func() {
+			_, err := injector.SafeGet(new(Store))
+			done <- err
+		}()
+		select {
+		case err := <-done:
+			return NewStoreOnMemory(), err
+		case <-time.After(time.Second):
+			return nil, errors.New("a custom scope should not block other lookups of the same key")
+		}
+	}
+	var err error
+	injector, err = CreateInjector(func(binder Binder) {
+		binder.BindScope(PerJob, goroutineScope{})
+		binder.Bind(new(Store)).ToConstructor(newStore).In(PerJob)
+	})
+	if err != nil {
+		t.Fatalf("fatal: %v", err)
+	}
+	if _, err := injector.SafeGet(new(Store)); err != nil {
+		t.Fatalf("fatal: %v", err)
+	}
 }
@@ -1,31 +1,42 @@
 package shot
 
+import (
+	"context"
+	"errors"
+)
+
 type Configure func(binder Binder)
 
+func New(opts ...Option) (Injector, error) {
+	return newInternalInjectorCreator(newOptions(opts...)).build()
+}
+
 func CreateInjectorIgnoreTag(configures ...Configure) (Injector, error) {
-	return newInternalInjectorCreator(false).
-		addConfigures(configures...).
-		build()
+	return New(WithConfigures(configures...), WithTagMode(TagIgnored))
 }
 
 func CreateInjector(configures ...Configure) (Injector, error) {
-	return newInternalInjectorCreator(true).
-		addConfigures(configures...).
-		build()
+	return New(WithConfigures(configures...))
 }
 
-func newInternalInjectorCreator(tagOnly bool) *internalInjectorCreator {
+func newInternalInjectorCreator(options options) *internalInjectorCreator {
 	return &internalInjectorCreator{
 		binder:     newBinder(),
 		configures: []Configure{},
-		tagOnly:    tagOnly,
+		options:    options,
 	}
 }
 
 type internalInjectorCreator struct {
 	binder     Binder
 	configures []Configure
-	tagOnly    bool
+	options    options
+	parent     Injector
+}
+
+func (creator *internalInjectorCreator) withParent(parent Injector) *internalInjectorCreator {
+	creator.parent = parent
+	return creator
 }
 
 func (creator *internalInjectorCreator) addConfigures(configures ...Configure) *internalInjectorCreator {
@@ -35,32 +46,73 @@ func (creator *internalInjectorCreator) addConfigures(configures ...Configure) *
 
 func (creator *internalInjectorCreator) build() (Injector, error) {
 
+	creator.binder.Install(creator.options.modules...)
 	for _, configure := range creator.configures {
-		configure(creator.binder)
+		creator.binder.Install(configure)
+	}
+	if creator.options.jit {
+		creator.binder.EnableJustInTimeBindings()
+	}
+	if creator.options.strict {
+		if creator.binder.getBindingPolicy().justInTime {
+			return nil, &CreationError{[]error{errors.New("just-in-time bindings can't be enabled with strict validation")}}
+		}
+		creator.binder.RequireExplicitBindings()
+	}
+
+	bindings := creator.binder.getBindingAll()
+	linkMultibindings(bindings)
+
+	justInTime := creator.binder.getBindingPolicy().allowJustInTime(creator.parent)
+	if justInTime {
+		bindings = append(bindings, justInTimeBindings(bindings, creator.options, creator.parent)...)
 	}
 
-	injector := newInjector()
+	if err := validateBindings(bindings, creator.binder.getScopeBindings(), creator.options, creator.parent); err != nil {
+		return nil, err
+	}
+
+	injector := newInjector(creator.parent, creator.options, justInTime)
+
+	for _, scopeBinding := range creator.binder.getScopeBindings() {
+		injector.setScopeImpl(scopeBinding.scope, scopeBinding.impl)
+	}
 
-	for _, binding := range creator.binder.getBindingAll() {
-		injectedBinding := binding.fill(injector, creator.tagOnly)
+	for _, binding := range bindings {
+		injectedBinding := binding.fill(injector, creator.options)
 		injector.set(binding.getKey(), injectedBinding)
 	}
 
-	for _, binding := range injector.getBindings() {
-		if err := binding.ok(); err != nil {
-			return nil, err
+	if err := loadEagerSingletons(injector, creator.options.stage); err != nil {
+		if stopErr := injector.getLifecycle().stop(context.Background()); stopErr != nil {
+			return nil, &CreationError{[]error{err, stopErr}}
 		}
+		return nil, err
 	}
 
-	loadEagerSingletons(injector)
+	creator.options.logf("shot: created injector with %d bindings", len(bindings))
 
 	return injector, nil
 }
 
-func loadEagerSingletons(injector Injector) {
-	for _, binding := range injector.getBindings() {
-		if _, ok := binding.(*eagerSingletonBinding); ok {
-			binding.get()
+func loadEagerSingletons(injector Injector, stage Stage) error {
+	for key, binding := range injector.getBindings() {
+		if !loadsEagerly(binding, stage) {
+			continue
+		}
+		if _, err := injector.getByKey(key, nil); err != nil {
+			return err
 		}
 	}
+	return nil
+}
+
+func loadsEagerly(binding filledBinding, stage Stage) bool {
+	switch binding.(type) {
+	case *eagerSingletonBinding:
+		return true
+	case *singletonBinding:
+		return stage == Production
+	}
+	return false
 }
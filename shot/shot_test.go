@@ -1,6 +1,8 @@
 package shot
 
 import (
+	"errors"
+	"strings"
 	"testing"
 )
 
@@ -526,3 +528,204 @@ func Test_it_should_be_inject_instance_directly(t *testing.T) {
 		t.Fatal("could not inject field of ProjectService")
 	}
 }
+
+type DataSource struct {
+	Primary string `inject:"name=primary"`
+	Replica string `inject:"name=replica"`
+}
+
+func Test_it_should_be_inject_named_bindings(t *testing.T) {
+	injector, err := CreateInjector(func(binder Binder) {
+		binder.Bind(new(string)).Named("primary").ToInstance("primary-dsn")
+		binder.Bind(new(string)).ToInstance("replica-dsn").Named("replica")
+		binder.Bind(new(DataSource))
+	})
+	if err != nil {
+		t.Fatalf("fatal: %v", err)
+	}
+	if primary := injector.GetNamed(new(string), "primary").(string); primary != "primary-dsn" {
+		t.Fatalf("Does not match. result: %s", primary)
+	}
+	if replica := injector.GetByKey(NewNamedKey(new(string), "replica")).(string); replica != "replica-dsn" {
+		t.Fatalf("Does not match. result: %s", replica)
+	}
+	if _, err := injector.SafeGet(new(string)); err == nil {
+		t.Fatal("an unnamed binding should not be found")
+	}
+	dataSource := injector.Get(new(DataSource)).(*DataSource)
+	if dataSource.Primary != "primary-dsn" || dataSource.Replica != "replica-dsn" {
+		t.Fatalf("could not inject named fields of DataSource: %+v", dataSource)
+	}
+}
+
+type Replicas struct {
+	Primary *StoreOnMemory `inject:"name=primary"`
+	Replica *StoreOnMemory `inject:"name=replica"`
+}
+
+func Test_it_should_be_inject_named_bindings_of_pointer_types(t *testing.T) {
+	primary, replica := NewStoreOnMemory(), NewStoreOnMemory()
+	injector, err := CreateInjector(func(binder Binder) {
+		binder.Bind(new(*StoreOnMemory)).Named("primary").ToInstance(primary)
+		binder.Bind(new(StoreOnMemory)).Named("replica").ToInstance(replica)
+		binder.Bind(new(Replicas))
+	})
+	if err != nil {
+		t.Fatalf("fatal: %v", err)
+	}
+	if NewNamedKey(new(*StoreOnMemory), "primary") != NewNamedKey(new(StoreOnMemory), "primary") {
+		t.Fatal("a key of a pointer type should match a key of its element type")
+	}
+	replicas := injector.Get(new(Replicas)).(*Replicas)
+	if replicas.Primary != primary || replicas.Replica != replica {
+		t.Fatalf("could not inject named fields of Replicas: %+v", replicas)
+	}
+}
+
+var errStoreUnavailable = errors.New("store is unavailable")
+
+func NewUnavailableStore() (*StoreOnMemory, error) {
+	return nil, errStoreUnavailable
+}
+
+func NewStoreOnMemoryWithError() (*StoreOnMemory, error) {
+	return NewStoreOnMemory(), nil
+}
+
+func Test_it_should_be_inject_implementation_via_constructor_returning_an_error(t *testing.T) {
+	injector, err := CreateInjector(func(binder Binder) {
+		binder.Bind(new(Store)).ToConstructor(NewStoreOnMemoryWithError)
+		binder.Bind(new(UserRepository)).ToConstructor(NewUserRepositoryOnMemory)
+	})
+	if err != nil {
+		t.Fatalf("fatal: %v", err)
+	}
+	userRepository := injector.Get(new(UserRepository)).(UserRepository)
+	if userRepository.FindAll() == nil {
+		t.Fatal("could not inject field of UserRepository")
+	}
+}
+
+func Test_it_should_be_return_an_error_of_constructor_of_eager_singleton(t *testing.T) {
+	_, err := CreateInjector(func(binder Binder) {
+		binder.Bind(new(Store)).ToConstructor(NewUnavailableStore)
+		binder.Bind(new(UserRepository)).ToConstructor(NewUserRepositoryOnMemory).AsEagerSingleton()
+	})
+	if err == nil {
+		t.Fatal("an error of constructor should be returned")
+	}
+	if cause := err.(interface{ Unwrap() error }).Unwrap(); cause != errStoreUnavailable {
+		t.Fatalf("Does not match. result: %v", cause)
+	}
+	expected := "could not provide shot.UserRepository -> shot.Store: store is unavailable"
+	if err.Error() != expected {
+		t.Fatalf("Does not match. result: %v", err)
+	}
+}
+
+func Test_it_should_be_return_an_error_of_constructor_via_safe_get(t *testing.T) {
+	var attempts int
+	newStore := func() (*StoreOnMemory, error) {
+		attempts++
+		if attempts == 1 {
+			return nil, errStoreUnavailable
+		}
+		return NewStoreOnMemory(), nil
+	}
+	injector, err := CreateInjector(func(binder Binder) {
+		binder.Bind(new(Store)).ToConstructor(newStore).In(SingletonInstance)
+		binder.Bind(new(UserRepository)).ToConstructor(NewUserRepositoryOnMemory)
+	})
+	if err != nil {
+		t.Fatalf("fatal: %v", err)
+	}
+	if _, err := injector.SafeGet(new(UserRepository)); err == nil {
+		t.Fatal("an error of constructor should be returned")
+	}
+	userRepository, err := injector.SafeGet(new(UserRepository))
+	if err != nil {
+		t.Fatalf("a failed singleton should be initialized again: %v", err)
+	}
+	if userRepository.(UserRepository).FindAll() == nil {
+		t.Fatal("could not inject field of UserRepository")
+	}
+	if store, _ := injector.SafeGet(new(Store)); store != userRepository.(*UserRepositoryOnMemory).Store {
+		t.Fatal("a singleton should be cached after it is initialized")
+	}
+}
+
+type StoreConfig struct {
+	Capacity int
+}
+
+type StoreProvider struct {
+	Config     *StoreConfig `inject:""`
+	MaxRetries int
+}
+
+func (provider *StoreProvider) Get() (*StoreOnMemory, error) {
+	if provider.Config == nil || provider.MaxRetries == 0 {
+		return nil, errStoreUnavailable
+	}
+	return NewStoreOnMemory(), nil
+}
+
+type ValueStoreProvider struct {
+	Config *StoreConfig `inject:""`
+}
+
+func (provider ValueStoreProvider) Get() (*StoreOnMemory, error) {
+	return NewStoreOnMemory(), nil
+}
+
+func Test_it_should_be_inject_implementation_via_provider(t *testing.T) {
+	provider := &StoreProvider{MaxRetries: 3}
+	configure := func(binder Binder) {
+		binder.Bind(new(StoreConfig)).In(SingletonInstance)
+		binder.Bind(new(Store)).ToProvider(provider)
+		binder.Bind(new(UserRepository)).ToConstructor(NewUserRepositoryOnMemory)
+	}
+	injector, err := CreateInjector(configure)
+	if err != nil {
+		t.Fatalf("fatal: %v", err)
+	}
+	another, err := CreateInjector(configure)
+	if err != nil {
+		t.Fatalf("fatal: %v", err)
+	}
+	userRepository := injector.Get(new(UserRepository)).(UserRepository)
+	if userRepository.FindAll() == nil {
+		t.Fatal("could not inject field of UserRepository")
+	}
+	if injector.Get(new(Store)) == injector.Get(new(Store)) {
+		t.Fatal("a provider should be called for each injection")
+	}
+	if _, err := another.SafeGet(new(Store)); err != nil {
+		t.Fatalf("fatal: %v", err)
+	}
+	if provider.Config != nil {
+		t.Fatalf("a provider should be copied per injector. result: %+v", provider)
+	}
+}
+
+func Test_it_should_be_report_invalid_provider(t *testing.T) {
+	_, err := CreateInjector(func(binder Binder) {
+		binder.Bind(new(Store)).ToProvider(&StoreOnMemory{})
+	})
+	if err == nil || !strings.Contains(err.Error(), "without Get method") {
+		t.Fatalf("Does not match. result: %v", err)
+	}
+	_, err = CreateInjector(func(binder Binder) {
+		binder.Bind(new(Store)).ToProvider(&StoreProvider{})
+	})
+	if err == nil || !strings.Contains(err.Error(), "shot.StoreConfig") {
+		t.Fatalf("Does not match. result: %v", err)
+	}
+	_, err = CreateInjector(func(binder Binder) {
+		binder.Bind(new(StoreConfig))
+		binder.Bind(new(Store)).ToProvider(ValueStoreProvider{})
+	})
+	if err == nil || !strings.Contains(err.Error(), "provider not pointer") {
+		t.Fatalf("Does not match. result: %v", err)
+	}
+}
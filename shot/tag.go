@@ -0,0 +1,27 @@
+package shot
+
+import "strings"
+
+const injectTagName = "inject"
+
+type injectTag struct {
+	name     string
+	optional bool
+	unknown  []string
+}
+
+func parseInjectTag(tag string) injectTag {
+	var parsed injectTag
+	for _, option := range strings.Split(tag, ",") {
+		option = strings.TrimSpace(option)
+		switch {
+		case strings.HasPrefix(option, "name="):
+			parsed.name = strings.TrimPrefix(option, "name=")
+		case option == "optional":
+			parsed.optional = true
+		case option != "":
+			parsed.unknown = append(parsed.unknown, option)
+		}
+	}
+	return parsed
+}
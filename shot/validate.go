@@ -0,0 +1,204 @@
+package shot
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+func validateBindings(bindings []binding, scopeBindings []scopeBinding, options options, parent Injector) error {
+	keys := make(map[Key]bool)
+	targets := make(map[Key]binding)
+	for _, binding := range bindings {
+		keys[binding.getKey()] = true
+		if _, ok := targets[binding.getKey()]; !ok {
+			targets[binding.getKey()] = binding
+		}
+	}
+	bound := func(key Key) bool {
+		if providedKey, ok := providerKey(key); ok && !keys[key] {
+			key = providedKey
+		}
+		return keys[key] || (parent != nil && parent.hasBinding(key))
+	}
+	var errs []error
+	scopes := make(map[Scope]bool)
+	for _, scopeBinding := range scopeBindings {
+		_, inParent := parentScopeImpl(parent, scopeBinding.scope)
+		switch {
+		case scopeBinding.impl == nil:
+			errs = append(errs, fmt.Errorf("a scope implementation for %v is nil", scopeBinding.scope))
+		case scopeBinding.scope.builtIn():
+			errs = append(errs, fmt.Errorf("%v is a built-in scope and can't be bound", scopeBinding.scope))
+		case scopes[scopeBinding.scope] || inParent:
+			errs = append(errs, fmt.Errorf("a scope implementation for %v was already bound", scopeBinding.scope))
+		}
+		scopes[scopeBinding.scope] = true
+	}
+	declared := make(map[Key]binding)
+	for _, binding := range bindings {
+		if previous, ok := declared[binding.getKey()]; ok {
+			if !mergeable(binding, previous) {
+				errs = append(errs, newDuplicateBindingError(binding, previous))
+			}
+			continue
+		}
+		declared[binding.getKey()] = binding
+		if parent != nil && parent.hasBinding(binding.getKey()) {
+			errs = append(errs, fmt.Errorf("a binding for %s was already configured in the parent injector", describeBinding(binding)))
+			continue
+		}
+		if !scopeBound(binding.getScope(), scopes, parent) {
+			errs = append(errs, fmt.Errorf("invalid binding for %s: no scope implementation is bound for %v", describeBinding(binding), binding.getScope()))
+			continue
+		}
+		if hasLifecycleHooks(binding) && binding.getScope() != SingletonInstance && binding.getScope() != EagerSingleton {
+			errs = append(errs, fmt.Errorf("invalid binding for %s: lifecycle hooks require %v or %v", describeBinding(binding), SingletonInstance, EagerSingleton))
+			continue
+		}
+		if err := binding.validate(options); err != nil {
+			errs = append(errs, fmt.Errorf("invalid binding for %s: %v", describeBinding(binding), err))
+			continue
+		}
+		if err := validateAssignable(binding.getKey(), binding.providedType()); err != nil {
+			errs = append(errs, fmt.Errorf("invalid binding for %s: %v", describeBinding(binding), err))
+			continue
+		}
+		for _, dependency := range binding.dependencies(options) {
+			if options.strict {
+				if err := validateInjectTag(dependency); err != nil {
+					errs = append(errs, fmt.Errorf("invalid binding for %s: %v", describeBinding(binding), err))
+				}
+			}
+			if err := validateInjectable(dependency, targets); err != nil {
+				errs = append(errs, fmt.Errorf("invalid binding for %s: %v", describeBinding(binding), err))
+			}
+			if !dependency.optional && !bound(dependency.key) {
+				errs = append(errs, &MissingDependencyError{
+					Key:       dependency.key,
+					Binding:   binding.getKey(),
+					Module:    binding.getSource(),
+					Requiring: dependency.requiring,
+					Field:     dependency.field,
+					Index:     dependency.index,
+				})
+			}
+		}
+	}
+	if err := detectCycle(bindings, options); err != nil {
+		errs = append(errs, err)
+	}
+	if len(errs) > 0 {
+		return &CreationError{errs}
+	}
+	return nil
+}
+
+func scopeBound(scope Scope, scopes map[Scope]bool, parent Injector) bool {
+	if scope.builtIn() || scopes[scope] {
+		return true
+	}
+	_, ok := parentScopeImpl(parent, scope)
+	return ok
+}
+
+func parentScopeImpl(parent Injector, scope Scope) (ScopeImpl, bool) {
+	if parent == nil {
+		return nil, false
+	}
+	return parent.getScopeImpl(scope)
+}
+
+func mergeable(binding binding, previous binding) bool {
+	_, ok := binding.(multibinding)
+	return ok && reflect.TypeOf(binding) == reflect.TypeOf(previous)
+}
+
+func newDuplicateBindingError(binding binding, previous binding) error {
+	if previous.getSource() == "" {
+		return fmt.Errorf("a binding for %s was already configured", describeBinding(binding))
+	}
+	return fmt.Errorf("a binding for %s was already configured in module %s", describeBinding(binding), previous.getSource())
+}
+
+func describeBinding(binding binding) string {
+	if binding.getSource() == "" {
+		return fmt.Sprint(binding.getKey())
+	}
+	return fmt.Sprintf("%v (module %s)", binding.getKey(), binding.getSource())
+}
+
+func validateAssignable(key Key, providedType reflect.Type) error {
+	keyType := key.ReflectType()
+	if providedType == nil || providedType.AssignableTo(keyType) || providedType.AssignableTo(reflect.PtrTo(keyType)) {
+		return nil
+	}
+	if keyType.Kind() != reflect.Interface {
+		return fmt.Errorf("%v is not assignable to %v", providedType, keyType)
+	}
+	var missing []string
+	for i := 0; i < keyType.NumMethod(); i++ {
+		if method := keyType.Method(i); !implementsMethod(providedType, method) {
+			missing = append(missing, method.Name)
+		}
+	}
+	return fmt.Errorf("%v does not implement %v (missing method %s)", providedType, keyType, strings.Join(missing, ", "))
+}
+
+func implementsMethod(providedType reflect.Type, method reflect.Method) bool {
+	implemented, ok := providedType.MethodByName(method.Name)
+	if !ok {
+		return false
+	}
+	if providedType.Kind() == reflect.Interface {
+		return implemented.Type == method.Type
+	}
+	implementedType := implemented.Type
+	if implementedType.NumIn()-1 != method.Type.NumIn() || implementedType.NumOut() != method.Type.NumOut() {
+		return false
+	}
+	for i := 0; i < method.Type.NumIn(); i++ {
+		if method.Type.In(i) != implementedType.In(i+1) {
+			return false
+		}
+	}
+	for i := 0; i < method.Type.NumOut(); i++ {
+		if method.Type.Out(i) != implementedType.Out(i) {
+			return false
+		}
+	}
+	return method.Type.IsVariadic() == implementedType.IsVariadic()
+}
+
+func validateInjectTag(dependency dependency) error {
+	if dependency.field == "" {
+		return nil
+	}
+	structField, ok := dependency.requiring.FieldByName(dependency.field)
+	if !ok {
+		return nil
+	}
+	if unknown := parseInjectTag(structField.Tag.Get(injectTagName)).unknown; len(unknown) > 0 {
+		return fmt.Errorf("unknown inject tag option %q on field %s of %v", unknown[0], dependency.field, dependency.requiring)
+	}
+	return nil
+}
+
+func validateInjectable(dependency dependency, targets map[Key]binding) error {
+	key, valueType := dependency.key, dependency.valueType
+	if providedKey, ok := providerKey(key); ok && targets[key] == nil {
+		key, valueType = providedKey, valueType.Out(0)
+	}
+	target := targets[key]
+	if target == nil || valueType == nil {
+		return nil
+	}
+	providedType := target.providedType()
+	if providedType == nil || providedType.AssignableTo(valueType) {
+		return nil
+	}
+	if dependency.field != "" {
+		return fmt.Errorf("can't inject %v into field %s of %v (type %v)", providedType, dependency.field, dependency.requiring, valueType)
+	}
+	return fmt.Errorf("can't inject %v into parameter %d of %v (type %v)", providedType, dependency.index, dependency.requiring, valueType)
+}
@@ -0,0 +1,136 @@
+package shot
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func Test_it_should_be_construct_singletons_only_once(t *testing.T) {
+	var constructed int
+	newStore := func() *StoreOnMemory {
+		constructed++
+		return NewStoreOnMemory()
+	}
+	injector, err := CreateInjector(func(binder Binder) {
+		binder.Bind(new(Store)).ToConstructor(newStore).In(SingletonInstance)
+		binder.Bind(new(UserRepository)).ToConstructor(NewUserRepositoryOnMemory).AsEagerSingleton()
+		binder.Bind(new(GroupRepository)).ToConstructor(NewGroupRepositoryOnMemory).AsEagerSingleton()
+	})
+	if err != nil {
+		t.Fatalf("fatal: %v", err)
+	}
+	injector.Get(new(Store))
+	injector.Get(new(UserRepository))
+	if constructed != 1 {
+		t.Fatalf("Does not match. result: %d", constructed)
+	}
+}
+
+func Test_it_should_not_be_construct_lazy_bindings_while_validating(t *testing.T) {
+	var constructed int
+	newStore := func() *StoreOnMemory {
+		constructed++
+		return NewStoreOnMemory()
+	}
+	_, err := CreateInjector(func(binder Binder) {
+		binder.Bind(new(Store)).ToConstructor(newStore)
+		binder.Bind(new(UserRepository)).ToConstructor(NewUserRepositoryOnMemory).In(SingletonInstance)
+	})
+	if err != nil {
+		t.Fatalf("fatal: %v", err)
+	}
+	if constructed != 0 {
+		t.Fatalf("Does not match. result: %d", constructed)
+	}
+}
+
+func Test_it_should_be_validate_shapes_of_bindings(t *testing.T) {
+	cases := map[string]Configure{
+		"constructor": func(binder Binder) {
+			binder.Bind(new(Store)).ToConstructor(func() (*StoreOnMemory, int) { return nil, 0 })
+		},
+		"not constructor": func(binder Binder) {
+			binder.Bind(new(Store)).ToConstructor(NewStoreOnMemory())
+		},
+		"not struct": func(binder Binder) {
+			binder.Bind(new(Store)).To(new(string))
+		},
+		"missing binding": func(binder Binder) {
+			binder.Bind(new(UserRepository)).ToConstructor(NewUserRepositoryOnMemory)
+		},
+	}
+	for name, configure := range cases {
+		if _, err := CreateInjector(configure); err == nil {
+			t.Fatalf("an invalid binding should be reported: %s", name)
+		}
+	}
+}
+
+func Test_it_should_be_report_all_missing_dependencies(t *testing.T) {
+	_, err := CreateInjector(func(binder Binder) {
+		binder.Bind(new(ProjectService)).ToConstructor(NewProjectService)
+		binder.Bind(new(UserRepository)).To(new(UserRepositoryOnMemory))
+	})
+	creationError, ok := err.(*CreationError)
+	if !ok {
+		t.Fatalf("a CreationError should be returned. result: %v", err)
+	}
+	if len(creationError.Errors) != 2 {
+		t.Fatalf("Does not match. result: %v", creationError)
+	}
+
+	groupRepository := creationError.Errors[0].(*MissingDependencyError)
+	if groupRepository.Key != NewKey(new(GroupRepository)) || groupRepository.Binding != NewKey(new(ProjectService)) || groupRepository.Index != 1 {
+		t.Fatalf("Does not match. result: %+v", groupRepository)
+	}
+
+	store := creationError.Errors[1].(*MissingDependencyError)
+	if store.Key != NewKey(new(Store)) || store.Binding != NewKey(new(UserRepository)) || store.Field != "Store" {
+		t.Fatalf("Does not match. result: %+v", store)
+	}
+	expected := "could not find a binding for shot.Store required by field Store of shot.UserRepositoryOnMemory (binding shot.UserRepository in module shot.Test_it_should_be_report_all_missing_dependencies.func1)"
+	if store.Error() != expected {
+		t.Fatalf("Does not match. result: %v", store)
+	}
+	var missing *MissingDependencyError
+	if !errors.As(err, &missing) || missing != groupRepository {
+		t.Fatalf("a MissingDependencyError should be found by errors.As. result: %v", missing)
+	}
+}
+
+type Counter interface {
+	Count() int
+	Reset()
+}
+
+func Test_it_should_be_validate_types_of_implementations(t *testing.T) {
+	cases := map[string]Configure{
+		"to": func(binder Binder) {
+			binder.Bind(new(Counter)).To(new(StoreOnMemory))
+		},
+		"constructor": func(binder Binder) {
+			binder.Bind(new(Counter)).ToConstructor(NewStoreOnMemory)
+		},
+		"instance": func(binder Binder) {
+			binder.Bind(new(Counter)).ToInstance(NewStoreOnMemory())
+		},
+	}
+	expected := ": *shot.StoreOnMemory does not implement shot.Counter (missing method Count, Reset)"
+	for name, configure := range cases {
+		_, err := CreateInjector(configure)
+		if err == nil {
+			t.Fatalf("an incompatible implementation should be reported: %s", name)
+		}
+		if !strings.HasPrefix(err.Error(), "invalid binding for shot.Counter") || !strings.HasSuffix(err.Error(), expected) {
+			t.Fatalf("Does not match. result: %v", err)
+		}
+	}
+
+	_, err := CreateInjector(func(binder Binder) {
+		binder.Bind(new(string)).ToInstance(1)
+	})
+	if err == nil || !strings.HasSuffix(err.Error(), ": int is not assignable to string") {
+		t.Fatalf("Does not match. result: %v", err)
+	}
+}